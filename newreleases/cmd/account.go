@@ -0,0 +1,186 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"newreleases.io/newreleases"
+)
+
+// account holds services of one NewReleases account that are needed to
+// transfer projects between accounts.
+type account struct {
+	projectsService               projectsService
+	tagsService                   tagsService
+	slackChannelsService          slackChannelsService
+	telegramChatsService          telegramChatsService
+	discordChannelsService        discordChannelsService
+	hangoutsChatWebhooksService   hangoutsChatWebhooksService
+	microsoftTeamsWebhooksService microsoftTeamsWebhooksService
+	mattermostWebhooksService     mattermostWebhooksService
+	rocketchatWebhooksService     rocketchatWebhooksService
+	matrixRoomsService            matrixRoomsService
+	webhooksService               webhooksService
+}
+
+// newAuthKeyAccount returns an account that is accessed with the provided
+// auth key and other client options from the command flags or the
+// configuration.
+func newAuthKeyAccount(cmd *cobra.Command, config *viper.Viper, authKey string) (a *account, err error) {
+	o, err := newClientOptions(cmd, config)
+	if err != nil {
+		return nil, err
+	}
+	p, err := newRetryPolicy(cmd)
+	if err != nil {
+		return nil, err
+	}
+	client := newreleases.NewClient(authKey, o)
+	return &account{
+		projectsService:               newRetryingProjectsService(client.Projects, p),
+		tagsService:                   client.Tags,
+		slackChannelsService:          client.SlackChannels,
+		telegramChatsService:          client.TelegramChats,
+		discordChannelsService:        client.DiscordChannels,
+		hangoutsChatWebhooksService:   client.HangoutsChatWebhooks,
+		microsoftTeamsWebhooksService: client.MicrosoftTeamsWebhooks,
+		mattermostWebhooksService:     client.MattermostWebhooks,
+		rocketchatWebhooksService:     client.RocketchatWebhooks,
+		matrixRoomsService:            client.MatrixRooms,
+		webhooksService:               client.Webhooks,
+	}, nil
+}
+
+// profileAccount returns an account that is accessed with the auth key and
+// other options of the named profile from the config file.
+func (c *command) profileAccount(cmd *cobra.Command, profile string) (a *account, err error) {
+	config, err := c.profileConfig(profile)
+	if err != nil {
+		return nil, err
+	}
+	authKey := config.GetString(optionNameAuthKey)
+	if authKey == "" {
+		return nil, fmt.Errorf("auth key not configured in profile %q", profile)
+	}
+	return c.newAccount(cmd, config, authKey)
+}
+
+// configuredAccount returns services of the account from the configuration,
+// that are set by setAccountServices.
+func (c *command) configuredAccount() *account {
+	return &account{
+		projectsService:               c.projectsService,
+		tagsService:                   c.tagsService,
+		slackChannelsService:          c.slackChannelsService,
+		telegramChatsService:          c.telegramChatsService,
+		discordChannelsService:        c.discordChannelsService,
+		hangoutsChatWebhooksService:   c.hangoutsChatWebhooksService,
+		microsoftTeamsWebhooksService: c.microsoftTeamsWebhooksService,
+		mattermostWebhooksService:     c.mattermostWebhooksService,
+		rocketchatWebhooksService:     c.rocketchatWebhooksService,
+		matrixRoomsService:            c.matrixRoomsService,
+		webhooksService:               c.webhooksService,
+	}
+}
+
+// notificationTargetNames returns names of notification targets by their
+// IDs, for every kind of notification with the same key as in the manifest.
+func (a *account) notificationTargetNames(ctx context.Context) (names map[string]map[string]string, err error) {
+	names = make(map[string]map[string]string)
+
+	slackChannels, err := a.slackChannelsService.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names["slack"] = make(map[string]string)
+	for _, e := range slackChannels {
+		names["slack"][e.ID] = e.TeamName + " #" + e.Channel
+	}
+
+	telegramChats, err := a.telegramChatsService.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names["telegram"] = make(map[string]string)
+	for _, e := range telegramChats {
+		names["telegram"][e.ID] = e.Name
+	}
+
+	discordChannels, err := a.discordChannelsService.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names["discord"] = make(map[string]string)
+	for _, e := range discordChannels {
+		names["discord"][e.ID] = e.Name
+	}
+
+	matrixRooms, err := a.matrixRoomsService.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names["matrix"] = make(map[string]string)
+	for _, e := range matrixRooms {
+		names["matrix"][e.ID] = e.Name
+	}
+
+	for _, w := range []struct {
+		key     string
+		service interface {
+			List(ctx context.Context) ([]newreleases.Webhook, error)
+		}
+	}{
+		{"hangouts-chat", a.hangoutsChatWebhooksService},
+		{"microsoft-teams", a.microsoftTeamsWebhooksService},
+		{"mattermost", a.mattermostWebhooksService},
+		{"rocketchat", a.rocketchatWebhooksService},
+		{"webhook", a.webhooksService},
+	} {
+		webhooks, err := w.service.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+		names[w.key] = make(map[string]string)
+		for _, e := range webhooks {
+			names[w.key][e.ID] = e.Name
+		}
+	}
+	return names, nil
+}
+
+// mapTagsByName finds tags with the same names in the account, adding the
+// ones that do not exist. It returns a map of provided tag IDs to the tag IDs
+// in the account and the number of added tags.
+func (a *account) mapTagsByName(ctx context.Context, tags []newreleases.Tag) (ids map[string]string, added int, err error) {
+	existing, err := a.tagsService.List(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	byName := make(map[string]string, len(existing))
+	for _, t := range existing {
+		byName[t.Name] = t.ID
+	}
+
+	ids = make(map[string]string, len(tags))
+	for _, t := range tags {
+		if id, ok := byName[t.Name]; ok {
+			ids[t.ID] = id
+			continue
+		}
+		tag, err := a.tagsService.Add(ctx, t.Name)
+		if err != nil {
+			return nil, 0, fmt.Errorf("add tag %s: %w", t.Name, err)
+		}
+		byName[tag.Name] = tag.ID
+		ids[t.ID] = tag.ID
+		added++
+	}
+	return ids, added, nil
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bufio"
+	"io"
+
+	"github.com/spf13/cobra"
+	"newreleases.io/newreleases"
+)
+
+const (
+	optionNameFile  = "file"
+	optionNamePrune = "prune"
+)
+
+func (c *command) initApplyCmd() (err error) {
+	optionNameAutoApprove := "auto-approve"
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Add, update and remove tracked projects to match a manifest file",
+		Long: `Add, update and remove tracked projects to match a manifest file.
+
+The manifest is a YAML or JSON file with the list of all projects that should
+be tracked and their options:
+
+  projects:
+    - provider: github
+      name: golang/go
+      email: daily
+      slack: [zetyksdfeymdsbe60td5gwgxt4]
+      regex-exclude:
+        - value: ^0\.1
+        - value: ^1\.
+          inverse: true
+      exclude-prereleases: true
+      note: Go programming language
+      tags: [33f1db7254b9]
+
+Options that are not specified are disabled. Projects that are tracked, but
+not listed in the manifest are removed only with the --prune flag.
+
+The plan of changes is printed and applied only after the confirmation.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			flags := cmd.Flags()
+			file, err := flags.GetString(optionNameFile)
+			if err != nil {
+				return err
+			}
+			autoApprove, err := flags.GetBool(optionNameAutoApprove)
+			if err != nil {
+				return err
+			}
+			prune, err := flags.GetBool(optionNamePrune)
+			if err != nil {
+				return err
+			}
+
+			m, err := readManifestFile(file)
+			if err != nil {
+				return err
+			}
+
+			plan, err := c.newProjectsPlan(m, prune)
+			if err != nil {
+				return err
+			}
+
+			if len(plan) == 0 {
+				cmd.Println("No changes. Tracked projects match the manifest.")
+				return nil
+			}
+
+			printPlan(cmd, plan)
+
+			if !autoApprove {
+				cmd.Println()
+				answer, err := terminalPrompt(cmd, bufio.NewReader(cmd.InOrStdin()), "Apply changes (enter yes to confirm)")
+				if err != nil && err != io.EOF {
+					return err
+				}
+				if answer != "yes" {
+					cmd.Println("Changes are not applied.")
+					return nil
+				}
+			}
+
+			return c.applyPlan(cmd, plan)
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := addClientConfigOptions(cmd, c.config); err != nil {
+				return err
+			}
+			return c.setProjectsService(cmd, args)
+		},
+	}
+
+	cmd.Flags().StringP(optionNameFile, "f", "", "manifest file")
+	cmd.Flags().Bool(optionNameAutoApprove, false, "apply changes without confirmation")
+	cmd.Flags().Bool(optionNamePrune, false, "remove tracked projects that are not in the manifest")
+	if err := cmd.MarkFlagRequired(optionNameFile); err != nil {
+		return err
+	}
+
+	c.root.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+// newProjectsPlan returns changes needed for tracked projects to match the
+// manifest, including removals only if prune is true.
+func (c *command) newProjectsPlan(m *manifest, prune bool) (plan []planEntry, err error) {
+	ctx, cancel := newClientContext(c.config)
+	defer cancel()
+
+	projects, err := listAllProjects(ctx, c.projectsService, newreleases.ProjectListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return newPlan(m, projects, prune), nil
+}
+
+func printPlan(cmd *cobra.Command, plan []planEntry) {
+	var add, update, remove int
+	for _, e := range plan {
+		switch e.action {
+		case planActionAdd:
+			add++
+			cmd.Printf("+ %s %s\n", e.project.Provider, e.project.Name)
+		case planActionUpdate:
+			update++
+			cmd.Printf("~ %s %s\n", e.project.Provider, e.project.Name)
+			for _, c := range e.changes {
+				cmd.Printf("    %s: %s -> %s\n", c.name, c.from, c.to)
+			}
+		case planActionRemove:
+			remove++
+			cmd.Printf("- %s %s\n", e.project.Provider, e.project.Name)
+		}
+	}
+	cmd.Println()
+	cmd.Printf("Plan: %v to add, %v to update, %v to remove.\n", add, update, remove)
+}
+
+func (c *command) applyPlan(cmd *cobra.Command, plan []planEntry) (err error) {
+	for _, e := range plan {
+		if err := c.applyPlanEntry(cmd, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *command) applyPlanEntry(cmd *cobra.Command, e planEntry) (err error) {
+	ctx, cancel := newClientContext(c.config)
+	defer cancel()
+
+	p := e.project
+	switch e.action {
+	case planActionAdd:
+		if _, err := c.projectsService.Add(ctx, p.Provider, p.Name, p.options()); err != nil {
+			return err
+		}
+		cmd.Printf("Added %s %s.\n", p.Provider, p.Name)
+	case planActionUpdate:
+		if _, err := c.projectsService.UpdateByName(ctx, p.Provider, p.Name, p.options()); err != nil {
+			return err
+		}
+		cmd.Printf("Updated %s %s.\n", p.Provider, p.Name)
+	case planActionRemove:
+		if err := c.projectsService.DeleteByName(ctx, p.Provider, p.Name); err != nil {
+			return err
+		}
+		cmd.Printf("Removed %s %s.\n", p.Provider, p.Name)
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+func TestApplyCmd(t *testing.T) {
+	projects := []newreleases.Project{
+		{ID: "mdsbe60td5gwgzetyksdfeyxt4", Name: "golang/go", Provider: "github", EmailNotification: newreleases.EmailNotificationDaily},
+		{ID: "gwgzetyksdfeyxt4mdsbe60td5", Name: "vue", Provider: "npm", SlackIDs: []string{"zetyksdfeymdsbe60td5gwgxt4"}},
+		{ID: "ksdfeyxt4mdsbe60td5gwgzety", Name: "django", Provider: "pypi"},
+	}
+
+	for _, tc := range []struct {
+		name       string
+		manifest   string
+		args       []string
+		input      string
+		wantOutput string
+		wantCalls  []string
+		wantError  bool
+	}{
+		{
+			name: "no changes",
+			manifest: `projects:
+  - provider: github
+    name: golang/go
+    email: daily
+  - provider: npm
+    name: vue
+    slack: [zetyksdfeymdsbe60td5gwgxt4]
+  - provider: pypi
+    name: django
+`,
+			wantOutput: "No changes. Tracked projects match the manifest.\n",
+		},
+		{
+			name: "not confirmed",
+			manifest: `projects:
+  - provider: github
+    name: golang/go
+    email: weekly
+    regex-exclude:
+      - value: ^0\.1
+        inverse: true
+  - provider: pypi
+    name: django
+  - provider: github
+    name: newreleases/cli-go
+`,
+			args:       []string{"--prune"},
+			input:      "no\n",
+			wantOutput: "~ github golang/go\n    email: daily -> weekly\n    regex-exclude: [] -> [^0\\.1 (inverse)]\n+ github newreleases/cli-go\n- npm vue\n\nPlan: 1 to add, 1 to update, 1 to remove.\n\nApply changes (enter yes to confirm): Changes are not applied.\n",
+		},
+		{
+			name: "confirmed",
+			manifest: `{"projects": [
+  {"provider": "github", "name": "golang/go", "email": "daily"},
+  {"provider": "npm", "name": "vue"},
+  {"provider": "github", "name": "newreleases/cli-go", "tags": ["33f1db7254b9"]}
+]}`,
+			args:       []string{"--prune"},
+			input:      "yes\n",
+			wantOutput: "~ npm vue\n    slack: [zetyksdfeymdsbe60td5gwgxt4] -> []\n+ github newreleases/cli-go\n- pypi django\n\nPlan: 1 to add, 1 to update, 1 to remove.\n\nApply changes (enter yes to confirm): Updated npm vue.\nAdded github newreleases/cli-go.\nRemoved pypi django.\n",
+			wantCalls:  []string{"update npm vue", "add github newreleases/cli-go", "remove pypi django"},
+		},
+		{
+			name: "auto approve",
+			manifest: `projects:
+  - provider: github
+    name: golang/go
+    email: daily
+  - provider: npm
+    name: vue
+    slack: [zetyksdfeymdsbe60td5gwgxt4]
+`,
+			args:       []string{"--auto-approve", "--prune"},
+			wantOutput: "- pypi django\n\nPlan: 0 to add, 0 to update, 1 to remove.\nRemoved pypi django.\n",
+			wantCalls:  []string{"remove pypi django"},
+		},
+		{
+			name: "without prune",
+			manifest: `projects:
+  - provider: github
+    name: golang/go
+    email: weekly
+`,
+			args:       []string{"--auto-approve"},
+			wantOutput: "~ github golang/go\n    email: daily -> weekly\n\nPlan: 0 to add, 1 to update, 0 to remove.\nUpdated github golang/go.\n",
+			wantCalls:  []string{"update github golang/go"},
+		},
+		{
+			name:      "empty manifest",
+			manifest:  "\n",
+			args:      []string{"--auto-approve", "--prune"},
+			wantError: true,
+		},
+		{
+			name:      "manifest without projects",
+			manifest:  "# projects:\n",
+			args:      []string{"--auto-approve", "--prune"},
+			wantError: true,
+		},
+		{
+			name:      "truncated manifest",
+			manifest:  "projects:\n",
+			args:      []string{"--auto-approve", "--prune"},
+			wantError: true,
+		},
+		{
+			name:       "empty list of projects",
+			manifest:   "projects: []\n",
+			args:       []string{"--auto-approve", "--prune"},
+			wantOutput: "- github golang/go\n- npm vue\n- pypi django\n\nPlan: 0 to add, 0 to update, 3 to remove.\nRemoved github golang/go.\nRemoved npm vue.\nRemoved pypi django.\n",
+			wantCalls:  []string{"remove github golang/go", "remove npm vue", "remove pypi django"},
+		},
+		{
+			name: "unknown field",
+			manifest: `projects:
+  - provider: github
+    name: golang/go
+    emails: daily
+`,
+			wantError: true,
+		},
+		{
+			name: "duplicate project",
+			manifest: `projects:
+  - provider: github
+    name: golang/go
+  - provider: github
+    name: golang/go
+`,
+			wantError: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "projects.yaml")
+			if err := os.WriteFile(file, []byte(tc.manifest), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			s := newMockRecordingProjectsService(newMockProjectsService(1, nil, projects))
+
+			var outputBuf bytes.Buffer
+			err := newCommand(t,
+				cmd.WithArgs(append([]string{"apply", "-f", file}, tc.args...)...),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithInput(strings.NewReader(tc.input)),
+				cmd.WithProjectsService(s),
+			).Execute()
+			if tc.wantError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				if len(s.calls) > 0 {
+					t.Errorf("got calls %q, want none", s.calls)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if gotOutput := outputBuf.String(); gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+			if !reflect.DeepEqual(s.calls, tc.wantCalls) {
+				t.Errorf("got calls %q, want %q", s.calls, tc.wantCalls)
+			}
+		})
+	}
+}
+
+// mockRecordingProjectsService records calls that change tracked projects.
+type mockRecordingProjectsService struct {
+	mockProjectsService
+	calls []string
+	mu    sync.Mutex
+}
+
+func newMockRecordingProjectsService(s mockProjectsService) *mockRecordingProjectsService {
+	return &mockRecordingProjectsService{mockProjectsService: s}
+}
+
+func (s *mockRecordingProjectsService) record(call ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls = append(s.calls, strings.Join(call, " "))
+}
+
+func (s *mockRecordingProjectsService) Add(ctx context.Context, provider, name string, o *newreleases.ProjectOptions) (project *newreleases.Project, err error) {
+	s.record("add", provider, name)
+	return s.mockProjectsService.Add(ctx, provider, name, o)
+}
+
+func (s *mockRecordingProjectsService) UpdateByName(ctx context.Context, provider, name string, o *newreleases.ProjectOptions) (project *newreleases.Project, err error) {
+	s.record("update", provider, name)
+	return &newreleases.Project{Provider: provider, Name: name}, s.err
+}
+
+func (s *mockRecordingProjectsService) DeleteByName(ctx context.Context, provider, name string) (err error) {
+	s.record("remove", provider, name)
+	return s.err
+}
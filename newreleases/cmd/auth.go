@@ -26,6 +26,10 @@ func (c *command) initAuthCmd() (err error) {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, keys); ok || err != nil {
+				return err
+			}
+
 			if len(keys) == 0 {
 				cmd.Println("No auth keys found.")
 				return nil
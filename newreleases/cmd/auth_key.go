@@ -0,0 +1,155 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	optionNameAuthKeyCommand      = "auth-key-command"
+	optionNameAuthKeyStoreCommand = "auth-key-store-command"
+	optionNameAuthKeyFile         = "auth-key-file"
+)
+
+// getAuthKey returns the auth key from the auth-key option or, if it is not
+// set, from the encrypted credential store, if it is enabled, from the output
+// of the auth-key-command or from the content of the auth-key-file. The key is
+// decrypted, the command is run and the file is read only once. An empty
+// string is returned if the auth key is not configured.
+func (c *command) getAuthKey(cmd *cobra.Command) (authKey string, err error) {
+	if c.authKey != "" {
+		return c.authKey, nil
+	}
+	if authKey := c.config.GetString(optionNameAuthKey); authKey != "" {
+		return authKey, nil
+	}
+
+	if c.config.GetBool(optionNameCredentialStore) {
+		s, err := c.openCredentialStore(cmd, false)
+		if err != nil {
+			return "", err
+		}
+		if authKey, err = s.get(c.credentialName()); err != nil {
+			return "", err
+		}
+	} else if command := c.config.GetString(optionNameAuthKeyCommand); command != "" {
+		var stdout bytes.Buffer
+		if err := c.runAuthKeyCommand(cmd, "auth key command", command, nil, &stdout); err != nil {
+			return "", err
+		}
+		authKey = strings.TrimSpace(stdout.String())
+		if authKey == "" {
+			return "", fmt.Errorf("auth key command %q: no auth key in the output", command)
+		}
+	} else if filename := c.config.GetString(optionNameAuthKeyFile); filename != "" {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			return "", fmt.Errorf("auth key file: %w", err)
+		}
+		authKey = strings.TrimSpace(string(data))
+		if authKey == "" {
+			return "", fmt.Errorf("auth key file %s is empty", filename)
+		}
+	}
+
+	c.authKey = authKey
+	return authKey, nil
+}
+
+// saveAuthKey encrypts the auth key in the credential store, if it is
+// enabled, stores it with the auth-key-store-command, passing the key on its
+// standard input, or writes it to the auth-key-file, if any of them is
+// configured, or otherwise saves it in the config file.
+func (c *command) saveAuthKey(cmd *cobra.Command, authKey string) (err error) {
+	authKey = strings.TrimSpace(authKey)
+
+	if c.config.GetBool(optionNameCredentialStore) {
+		s, err := c.openCredentialStore(cmd, true)
+		if err != nil {
+			return err
+		}
+		name := c.credentialName()
+		if err := s.set(name, authKey); err != nil {
+			return fmt.Errorf("credential store: %w", err)
+		}
+		cmd.Printf("Auth key %q saved to the credential store: %s.\n", name, s.filename)
+		return nil
+	}
+
+	if command := c.config.GetString(optionNameAuthKeyStoreCommand); command != "" {
+		if err := c.runAuthKeyCommand(cmd, "auth key store command", command, strings.NewReader(authKey+"\n"), cmd.ErrOrStderr()); err != nil {
+			return err
+		}
+		cmd.Println("Auth key stored with the auth key store command.")
+		return nil
+	}
+
+	if filename := c.config.GetString(optionNameAuthKeyFile); filename != "" {
+		if err := os.WriteFile(filename, []byte(authKey+"\n"), 0o600); err != nil {
+			return fmt.Errorf("auth key file: %w", err)
+		}
+		cmd.Printf("Auth key saved to: %s.\n", filename)
+		return nil
+	}
+
+	if c.config.GetString(optionNameAuthKeyCommand) != "" {
+		return errors.New("auth key command is configured without the auth key store command")
+	}
+
+	if err := c.writeConfig(cmd, authKey); err != nil {
+		return err
+	}
+	c.printConfigSaved(cmd)
+	return nil
+}
+
+// runAuthKeyCommand runs the auth key helper command, so that it is stopped
+// if it does not finish within the timeout option, like when it waits for
+// a passphrase on a host without a terminal.
+func (c *command) runAuthKeyCommand(cmd *cobra.Command, name, command string, stdin io.Reader, stdout io.Writer) (err error) {
+	timeout := c.config.GetDuration(optionNameTimeout)
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	e := shellCommand(ctx, command)
+	e.Stdin = stdin
+	e.Stdout = stdout
+	e.Stderr = cmd.ErrOrStderr()
+	// Do not wait for processes started by the command that keep its output
+	// open after it is stopped.
+	e.WaitDelay = time.Second
+	if err := e.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("%s %q: timed out after %v", name, command, timeout)
+		}
+		return fmt.Errorf("%s %q: %w", name, command, err)
+	}
+	return nil
+}
+
+// shellCommand returns the command that runs the command line with the system
+// shell.
+func shellCommand(ctx context.Context, command string) *exec.Cmd {
+	if runtime.GOOS == "windows" {
+		return exec.CommandContext(ctx, "cmd", "/C", command)
+	}
+	return exec.CommandContext(ctx, "sh", "-c", command)
+}
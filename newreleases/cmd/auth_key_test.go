@@ -0,0 +1,183 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+)
+
+func TestAuthKeyHelpers(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "key")
+	if err := os.WriteFile(keyFile, []byte("  z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		config    string
+		wantError string
+	}{
+		{
+			name:      "command",
+			config:    "auth-key-command: echo z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n",
+			wantError: "not available in offline mode: response of tags.List is not cached",
+		},
+		{
+			name:      "failed command",
+			config:    "auth-key-command: exit 3\n",
+			wantError: `auth key command "exit 3": exit status 3`,
+		},
+		{
+			name:      "command without output",
+			config:    "auth-key-command: echo\n",
+			wantError: `auth key command "echo": no auth key in the output`,
+		},
+		{
+			name:      "command timeout",
+			config:    "auth-key-command: exec sleep 10\ntimeout: 100ms\n",
+			wantError: `auth key command "exec sleep 10": timed out after 100ms`,
+		},
+		{
+			name:      "file",
+			config:    "auth-key-file: " + keyFile + "\n",
+			wantError: "not available in offline mode: response of tags.List is not cached",
+		},
+		{
+			name:      "missing file",
+			config:    "auth-key-file: " + keyFile + "-missing\n",
+			wantError: "auth key file: open " + keyFile + "-missing: no such file or directory",
+		},
+		{
+			name:      "not configured",
+			wantError: "auth key not configured",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			homeDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(homeDir, ".newreleases.yaml"), []byte(tc.config), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			err := newCommand(t,
+				cmd.WithHomeDir(homeDir),
+				cmd.WithCacheDir(t.TempDir()),
+				cmd.WithArgs("tag", "list", "--offline"),
+				cmd.WithOutput(new(bytes.Buffer)),
+				cmd.WithErrorOutput(new(bytes.Buffer)),
+			).Execute()
+			if err == nil || err.Error() != tc.wantError {
+				t.Fatalf("got error %v, want %v", err, tc.wantError)
+			}
+		})
+	}
+}
+
+func TestConfigureCmd_authKeyHelpers(t *testing.T) {
+	authKey := "z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71"
+
+	for _, tc := range []struct {
+		name       string
+		configFunc func(dir string) string
+		wantOutput func(dir string) string
+		wantError  string
+	}{
+		{
+			name: "file",
+			configFunc: func(dir string) string {
+				return "auth-key-file: " + filepath.Join(dir, "key") + "\n"
+			},
+			wantOutput: func(dir string) string {
+				return fmt.Sprintf("Auth Key: Auth key saved to: %s.\n", filepath.Join(dir, "key"))
+			},
+		},
+		{
+			name: "store command",
+			configFunc: func(dir string) string {
+				return "auth-key-command: cat " + filepath.Join(dir, "key") + "\n" +
+					"auth-key-store-command: cat > " + filepath.Join(dir, "key") + "\n"
+			},
+			wantOutput: func(string) string {
+				return "Auth Key: Auth key stored with the auth key store command.\n"
+			},
+		},
+		{
+			name: "store command timeout",
+			configFunc: func(dir string) string {
+				return "auth-key-command: cat " + filepath.Join(dir, "key") + "\n" +
+					"auth-key-store-command: exec sleep 10\n" +
+					"timeout: 100ms\n"
+			},
+			wantOutput: func(string) string {
+				return "Auth Key: "
+			},
+			wantError: `auth key store command "exec sleep 10": timed out after 100ms`,
+		},
+		{
+			name: "command without store command",
+			configFunc: func(dir string) string {
+				return "auth-key-command: cat " + filepath.Join(dir, "key") + "\n"
+			},
+			wantOutput: func(string) string {
+				return "Auth Key: "
+			},
+			wantError: "auth key command is configured without the auth key store command",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			cfgFile := filepath.Join(dir, ".newreleases.yaml")
+			config := tc.configFunc(dir)
+			if err := os.WriteFile(cfgFile, []byte(config), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			var outputBuf bytes.Buffer
+			err := newCommand(t,
+				cmd.WithHomeDir(dir),
+				cmd.WithArgs("configure"),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithInput(strings.NewReader(authKey+"\n")),
+			).Execute()
+			if tc.wantError != "" {
+				if err == nil || err.Error() != tc.wantError {
+					t.Fatalf("got error %v, want %v", err, tc.wantError)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if gotOutput, wantOutput := outputBuf.String(), tc.wantOutput(dir); gotOutput != wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+			}
+
+			gotConfig, err := os.ReadFile(cfgFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(gotConfig) != config {
+				t.Errorf("got config file data %q, want %q", gotConfig, config)
+			}
+
+			if tc.wantError == "" {
+				gotKey, err := os.ReadFile(filepath.Join(dir, "key"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(gotKey) != authKey+"\n" {
+					t.Errorf("got auth key %q, want %q", gotKey, authKey+"\n")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"newreleases.io/newreleases"
+)
+
+// backupVersion is the version of the backup file format.
+const backupVersion = 1
+
+// backup holds all account data that can be retrieved from the API.
+type backup struct {
+	Version                int                          `json:"version"`
+	Created                time.Time                    `json:"created"`
+	Projects               []newreleases.Project        `json:"projects"`
+	Tags                   []newreleases.Tag            `json:"tags"`
+	SlackChannels          []newreleases.SlackChannel   `json:"slack_channels"`
+	TelegramChats          []newreleases.TelegramChat   `json:"telegram_chats"`
+	DiscordChannels        []newreleases.DiscordChannel `json:"discord_channels"`
+	HangoutsChatWebhooks   []newreleases.Webhook        `json:"hangouts_chat_webhooks"`
+	MicrosoftTeamsWebhooks []newreleases.Webhook        `json:"microsoft_teams_webhooks"`
+	MattermostWebhooks     []newreleases.Webhook        `json:"mattermost_webhooks"`
+	RocketchatWebhooks     []newreleases.Webhook        `json:"rocketchat_webhooks"`
+	MatrixRooms            []newreleases.MatrixRoom     `json:"matrix_rooms"`
+	Webhooks               []newreleases.Webhook        `json:"webhooks"`
+}
+
+func (c *command) initBackupCmd() (err error) {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Save all projects, tags and notification channels to a file",
+		Long: `Save all tracked projects, tags and notification channels to a JSON file.
+
+If the file is not specified, the backup is written to the standard output.
+Projects and tags can be recreated from the backup with the restore command.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			ctx, cancel := newClientContext(c.config)
+			defer cancel()
+
+			file, err := cmd.Flags().GetString(optionNameFile)
+			if err != nil {
+				return err
+			}
+
+			b := backup{
+				Version: backupVersion,
+				Created: time.Now().UTC(),
+			}
+			if b.Projects, err = listAllProjects(ctx, c.projectsService, newreleases.ProjectListOptions{}); err != nil {
+				return err
+			}
+			if b.Tags, err = c.tagsService.List(ctx); err != nil {
+				return err
+			}
+			if b.SlackChannels, err = c.slackChannelsService.List(ctx); err != nil {
+				return err
+			}
+			if b.TelegramChats, err = c.telegramChatsService.List(ctx); err != nil {
+				return err
+			}
+			if b.DiscordChannels, err = c.discordChannelsService.List(ctx); err != nil {
+				return err
+			}
+			if b.HangoutsChatWebhooks, err = c.hangoutsChatWebhooksService.List(ctx); err != nil {
+				return err
+			}
+			if b.MicrosoftTeamsWebhooks, err = c.microsoftTeamsWebhooksService.List(ctx); err != nil {
+				return err
+			}
+			if b.MattermostWebhooks, err = c.mattermostWebhooksService.List(ctx); err != nil {
+				return err
+			}
+			if b.RocketchatWebhooks, err = c.rocketchatWebhooksService.List(ctx); err != nil {
+				return err
+			}
+			if b.MatrixRooms, err = c.matrixRoomsService.List(ctx); err != nil {
+				return err
+			}
+			if b.Webhooks, err = c.webhooksService.List(ctx); err != nil {
+				return err
+			}
+
+			data, err := json.MarshalIndent(b, "", "  ")
+			if err != nil {
+				return err
+			}
+			data = append(data, '\n')
+
+			if file == "" {
+				_, err = cmd.OutOrStdout().Write(data)
+				return err
+			}
+			if err := os.WriteFile(file, data, 0o600); err != nil {
+				return err
+			}
+			cmd.Printf("Backup of %v projects and %v tags saved to: %s.\n", len(b.Projects), len(b.Tags), file)
+			return nil
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := addClientConfigOptions(cmd, c.config); err != nil {
+				return err
+			}
+			return c.setAccountServices(cmd, args)
+		},
+	}
+
+	cmd.Flags().StringP(optionNameFile, "f", "", "backup file, standard output if not specified")
+
+	c.root.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+func (c *command) initRestoreCmd() (err error) {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Recreate tags and projects from a backup file",
+		Long: `Recreate tags and projects from a backup file created with the backup command.
+
+Tags are added if there are no tags with the same names. Projects are added
+with the options from the backup, referencing restored tags, unless they are
+already tracked. Restore can be safely repeated.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			file, err := cmd.Flags().GetString(optionNameFile)
+			if err != nil {
+				return err
+			}
+
+			b, err := readBackupFile(file)
+			if err != nil {
+				return err
+			}
+
+			tagIDs, err := c.restoreTags(cmd, b.Tags)
+			if err != nil {
+				return err
+			}
+			return c.restoreProjects(cmd, b.Projects, tagIDs)
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := addClientConfigOptions(cmd, c.config); err != nil {
+				return err
+			}
+			if err := c.setProjectsService(cmd, args); err != nil {
+				return err
+			}
+			return c.setTagsService(cmd, args)
+		},
+	}
+
+	cmd.Flags().StringP(optionNameFile, "f", "", "backup file")
+	if err := cmd.MarkFlagRequired(optionNameFile); err != nil {
+		return err
+	}
+
+	c.root.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+func readBackupFile(filename string) (b *backup, err error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	b = new(backup)
+	if err := json.Unmarshal(data, b); err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	if b.Version != backupVersion {
+		return nil, fmt.Errorf("%s: unsupported backup version %v", filename, b.Version)
+	}
+	return b, nil
+}
+
+// restoreTags adds tags that do not exist by name and returns a map of tag
+// IDs from the backup to the IDs of existing or added tags.
+func (c *command) restoreTags(cmd *cobra.Command, tags []newreleases.Tag) (ids map[string]string, err error) {
+	ctx, cancel := newClientContext(c.config)
+	defer cancel()
+
+	ids, added, err := c.configuredAccount().mapTagsByName(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+	cmd.Printf("Tags: %v added, %v already existing.\n", added, len(tags)-added)
+	return ids, nil
+}
+
+// restoreProjects adds projects that are not already tracked, replacing their
+// tag IDs with the ones from the provided map.
+func (c *command) restoreProjects(cmd *cobra.Command, projects []newreleases.Project, tagIDs map[string]string) (err error) {
+	ctx, cancel := newClientContext(c.config)
+	defer cancel()
+
+	existing, err := listAllProjects(ctx, c.projectsService, newreleases.ProjectListOptions{})
+	if err != nil {
+		return err
+	}
+	tracked := make(map[string]struct{}, len(existing))
+	for _, p := range existing {
+		tracked[p.Provider+"/"+p.Name] = struct{}{}
+	}
+
+	var added int
+	for _, p := range projects {
+		if _, ok := tracked[p.Provider+"/"+p.Name]; ok {
+			continue
+		}
+		mp := newManifestProject(p)
+		mp.Tags = nil
+		for _, id := range p.TagIDs {
+			if newID, ok := tagIDs[id]; ok {
+				mp.Tags = append(mp.Tags, newID)
+			}
+		}
+		if err := c.addProject(mp); err != nil {
+			return fmt.Errorf("add project %s %s: %w", p.Provider, p.Name, err)
+		}
+		added++
+	}
+	cmd.Printf("Projects: %v added, %v already tracked.\n", added, len(projects)-added)
+	return nil
+}
+
+func (c *command) addProject(p manifestProject) (err error) {
+	ctx, cancel := newClientContext(c.config)
+	defer cancel()
+
+	_, err = c.projectsService.Add(ctx, p.Provider, p.Name, p.options())
+	return err
+}
+
+// setAccountServices sets services for all account data that is included in
+// the backup.
+func (c *command) setAccountServices(cmd *cobra.Command, args []string) (err error) {
+	for _, set := range []func(*cobra.Command, []string) error{
+		c.setProjectsService,
+		c.setTagsService,
+		c.setSlackChannelsService,
+		c.setTelegramChatsService,
+		c.setDiscordChannelsService,
+		c.setHangoutsChatWebhooksService,
+		c.setMicrosoftTeamsWebhooksService,
+		c.setMattermostWebhooksService,
+		c.setRocketchatWebhooksService,
+		c.setMatrixRoomsService,
+		c.setWebhooksService,
+	} {
+		if err := set(cmd, args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+func TestBackupCmd(t *testing.T) {
+	projects := []newreleases.Project{minimalProject, fullProject}
+	slackChannels := []newreleases.SlackChannel{{ID: "zetyksdfeymdsbe60td5gwgxt4", TeamName: "NewReleases", Channel: "general"}}
+	webhooks := []newreleases.Webhook{{ID: "e6t0td5ykgwgxtzed4eymsbsdf", Name: "Builds"}}
+
+	file := filepath.Join(t.TempDir(), "backup.json")
+
+	var outputBuf bytes.Buffer
+	if err := newCommand(t,
+		cmd.WithArgs("backup", "-f", file),
+		cmd.WithOutput(&outputBuf),
+		cmd.WithProjectsService(newMockProjectsService(1, nil, projects)),
+		cmd.WithTagsService(newMockTagsService(fullTags, nil)),
+		cmd.WithSlackChannelsService(newMockSlackChannelsService(slackChannels, nil)),
+		cmd.WithTelegramChatsService(newMockTelegramChatssService(nil, nil)),
+		cmd.WithDiscordChannelsService(newMockDiscordChannelsService(nil, nil)),
+		cmd.WithHangoutsChatWebhooksService(newMockHangoutsChatWebhooksService(nil, nil)),
+		cmd.WithMicrosoftTeamsWebhooksService(newMockMicrosoftTeamsWebhooksService(nil, nil)),
+		cmd.WithMattermostWebhooksService(newMockMattermostWebhooksService(nil, nil)),
+		cmd.WithRocketchatWebhooksService(newMockRocketchatWebhooksService(nil, nil)),
+		cmd.WithMatrixRoomsService(newMockMatrixRoomsService(nil, nil)),
+		cmd.WithWebhooksService(newMockWebhooksService(webhooks, nil)),
+	).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	wantOutput := fmt.Sprintf("Backup of 2 projects and 2 tags saved to: %s.\n", file)
+	if gotOutput := outputBuf.String(); gotOutput != wantOutput {
+		t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Version       int                        `json:"version"`
+		Projects      []newreleases.Project      `json:"projects"`
+		Tags          []newreleases.Tag          `json:"tags"`
+		SlackChannels []newreleases.SlackChannel `json:"slack_channels"`
+		Webhooks      []newreleases.Webhook      `json:"webhooks"`
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != 1 {
+		t.Errorf("got version %v, want 1", got.Version)
+	}
+	if !reflect.DeepEqual(got.Projects, projects) {
+		t.Errorf("got projects %+v, want %+v", got.Projects, projects)
+	}
+	if !reflect.DeepEqual(got.Tags, fullTags) {
+		t.Errorf("got tags %+v, want %+v", got.Tags, fullTags)
+	}
+	if !reflect.DeepEqual(got.SlackChannels, slackChannels) {
+		t.Errorf("got slack channels %+v, want %+v", got.SlackChannels, slackChannels)
+	}
+	if !reflect.DeepEqual(got.Webhooks, webhooks) {
+		t.Errorf("got webhooks %+v, want %+v", got.Webhooks, webhooks)
+	}
+}
+
+func TestRestoreCmd(t *testing.T) {
+	backup, err := json.Marshal(map[string]interface{}{
+		"version": 1,
+		"projects": []newreleases.Project{
+			{ID: "mdsbe60td5gwgzetyksdfeyxt4", Name: "golang/go", Provider: "github", TagIDs: []string{"33f1db7254b9", "1d33b7254b9f"}},
+			{ID: "gwgzetyksdfeyxt4mdsbe60td5", Name: "vue", Provider: "npm", EmailNotification: newreleases.EmailNotificationDaily, TagIDs: []string{"1d33b7254b9f"}},
+		},
+		"tags": fullTags,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "backup.json")
+	if err := os.WriteFile(file, backup, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	projectsService := &mockRestoreProjectsService{
+		mockProjectsService: newMockProjectsService(1, nil, []newreleases.Project{
+			{ID: "xt4mdsbe60td5gwgzetyksdfey", Name: "golang/go", Provider: "github"},
+		}),
+	}
+
+	var outputBuf bytes.Buffer
+	if err := newCommand(t,
+		cmd.WithArgs("restore", "-f", file),
+		cmd.WithOutput(&outputBuf),
+		cmd.WithProjectsService(projectsService),
+		cmd.WithTagsService(newMockTagsService([]newreleases.Tag{{ID: "a8d9f7254b9f", Name: "Cool"}}, nil)),
+	).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	wantOutput := "Tags: 1 added, 1 already existing.\nProjects: 1 added, 1 already tracked.\n"
+	if gotOutput := outputBuf.String(); gotOutput != wantOutput {
+		t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+	}
+
+	wantAdded := []string{"npm vue daily [new]"}
+	if !reflect.DeepEqual(projectsService.added, wantAdded) {
+		t.Errorf("got added projects %q, want %q", projectsService.added, wantAdded)
+	}
+}
+
+func TestRestoreCmd_unsupportedVersion(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "backup.json")
+	if err := os.WriteFile(file, []byte(`{"version": 2}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	err := newCommand(t,
+		cmd.WithArgs("restore", "-f", file),
+		cmd.WithProjectsService(newMockProjectsService(1, nil)),
+		cmd.WithTagsService(newMockTagsService(nil, nil)),
+	).Execute()
+	if err == nil || !strings.Contains(err.Error(), "unsupported backup version 2") {
+		t.Fatalf("got error %v, want unsupported backup version", err)
+	}
+}
+
+type mockRestoreProjectsService struct {
+	mockProjectsService
+	added []string
+}
+
+func (s *mockRestoreProjectsService) Add(ctx context.Context, provider, name string, o *newreleases.ProjectOptions) (project *newreleases.Project, err error) {
+	s.added = append(s.added, fmt.Sprintf("%s %s %s %v", provider, name, *o.EmailNotification, o.TagIDs))
+	return s.mockProjectsService.Add(ctx, provider, name, o)
+}
@@ -0,0 +1,424 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"newreleases.io/newreleases"
+)
+
+const (
+	optionNameCacheTTL = "cache-ttl"
+	optionNameNoCache  = "no-cache"
+	optionNameOffline  = "offline"
+)
+
+// annotationNoCache marks commands that poll the API for changes, for which
+// responses must not be cached.
+const annotationNoCache = "no-cache"
+
+var errOffline = errors.New("not available in offline mode")
+
+// responseCache stores API responses as JSON files in a directory that is
+// specific to the API endpoint and the auth key. Responses that are older than ttl are fetched
+// again, except in offline mode, when only cached responses are returned,
+// regardless of their age. Responses that can not be read from or written to
+// the directory are fetched, reporting write errors to log.
+type responseCache struct {
+	dir     string
+	ttl     time.Duration
+	offline bool
+	log     io.Writer
+	now     func() time.Time
+}
+
+func newResponseCache(dir, endpoint, authKey string, ttl time.Duration, offline bool, log io.Writer) *responseCache {
+	h := sha256.Sum256([]byte(endpoint + "\n" + authKey))
+	return &responseCache{
+		dir:     filepath.Join(dir, hex.EncodeToString(h[:16])),
+		ttl:     ttl,
+		offline: offline,
+		log:     log,
+		now:     time.Now,
+	}
+}
+
+// cacheEntry is the content of a cache file.
+type cacheEntry struct {
+	Time  time.Time       `json:"time"`
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// cachePage holds elements of a listed page with the number of the last page.
+type cachePage[T any] struct {
+	Elements []T `json:"elements"`
+	LastPage int `json:"last_page"`
+}
+
+// cached returns the cached value for the method and its parameters if it is
+// not expired, or calls fetch and caches its result. Errors are not cached and
+// failures to cache the result do not fail the call.
+func cached[T any](c *responseCache, fetch func() (T, error), method string, params ...interface{}) (v T, err error) {
+	key, err := cacheKey(method, params...)
+	if err != nil {
+		return v, err
+	}
+	filename := c.filename(key)
+
+	if data, err := os.ReadFile(filename); err == nil {
+		var e cacheEntry
+		if err := json.Unmarshal(data, &e); err == nil && e.Key == key && (c.offline || c.now().Sub(e.Time) < c.ttl) {
+			if err := json.Unmarshal(e.Value, &v); err == nil {
+				return v, nil
+			}
+		}
+	}
+	if c.offline {
+		return v, fmt.Errorf("%w: response of %s is not cached", errOffline, strings.TrimSpace(fmt.Sprintln(append([]interface{}{method}, params...)...)))
+	}
+
+	v, err = fetch()
+	if err != nil {
+		return v, err
+	}
+	if err := c.write(filename, key, v); err != nil {
+		fmt.Fprintf(c.log, "Response of %s is not cached: %v\n", method, err)
+	}
+	return v, nil
+}
+
+// cacheKey returns the method name followed by the JSON encoded parameters.
+func cacheKey(method string, params ...interface{}) (key string, err error) {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	return method + string(data), nil
+}
+
+func (c *responseCache) filename(key string) string {
+	h := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(h[:])+".json")
+}
+
+func (c *responseCache) write(filename, key string, v interface{}) (err error) {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(cacheEntry{Time: c.now(), Key: key, Value: value})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0o700); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filename)
+}
+
+// modify calls the function that changes data on the service and removes all
+// cached responses, as any of them may be affected by the change.
+func (c *responseCache) modify(f func() error) (err error) {
+	if c.offline {
+		return errOffline
+	}
+	if err := f(); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(c.dir); err != nil {
+		return fmt.Errorf("cache: %w", err)
+	}
+	return nil
+}
+
+// addCacheConfigOptions binds cache flags to configuration options. They are
+// bound only when services are set, so that they are not written to the
+// configuration file by commands that save it.
+func addCacheConfigOptions(cmd *cobra.Command, config *viper.Viper) (err error) {
+	flags := cmd.Flags()
+	for _, name := range []string{optionNameCacheTTL, optionNameNoCache, optionNameOffline} {
+		if f := flags.Lookup(name); f != nil {
+			if err := config.BindPFlag(name, f); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// getResponseCache returns the response cache configured by command options,
+// or nil if responses should not be cached.
+func (c *command) getResponseCache(cmd *cobra.Command) (cache *responseCache, err error) {
+	if c.responseCache != nil {
+		return c.responseCache, nil
+	}
+	if cmd.Annotations[annotationNoCache] == "true" {
+		return nil, nil
+	}
+	if err := addCacheConfigOptions(cmd, c.config); err != nil {
+		return nil, err
+	}
+	offline := c.config.GetBool(optionNameOffline)
+	if c.config.GetBool(optionNameNoCache) {
+		if offline {
+			return nil, fmt.Errorf("--%s and --%s can not be used together", optionNameOffline, optionNameNoCache)
+		}
+		return nil, nil
+	}
+	ttl := c.config.GetDuration(optionNameCacheTTL)
+	if ttl <= 0 && !offline {
+		return nil, nil
+	}
+	dir := c.cacheDir
+	if dir == "" {
+		d, err := os.UserCacheDir()
+		if err != nil {
+			return nil, err
+		}
+		dir = filepath.Join(d, "newreleases")
+	}
+	endpoint, err := apiEndpoint(cmd, c.config)
+	if err != nil {
+		return nil, err
+	}
+	authKey, err := c.getAuthKey(cmd)
+	if err != nil {
+		return nil, err
+	}
+	c.responseCache = newResponseCache(dir, endpoint, authKey, ttl, offline, cmd.ErrOrStderr())
+	return c.responseCache, nil
+}
+
+// offlineTransport fails all HTTP requests, so that services that are not
+// cached do not make requests in offline mode.
+type offlineTransport struct{}
+
+func (offlineTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errOffline
+}
+
+func (c *command) cachedProjectsService(cmd *cobra.Command, s projectsService) (projectsService, error) {
+	if _, ok := s.(cachedProjectsService); ok {
+		return s, nil
+	}
+	cache, err := c.getResponseCache(cmd)
+	if err != nil || cache == nil {
+		return s, err
+	}
+	return cachedProjectsService{s: s, cache: cache}, nil
+}
+
+func (c *command) cachedReleasesService(cmd *cobra.Command, s releasesService) (releasesService, error) {
+	if _, ok := s.(cachedReleasesService); ok {
+		return s, nil
+	}
+	cache, err := c.getResponseCache(cmd)
+	if err != nil || cache == nil {
+		return s, err
+	}
+	return cachedReleasesService{s: s, cache: cache}, nil
+}
+
+func (c *command) cachedTagsService(cmd *cobra.Command, s tagsService) (tagsService, error) {
+	if _, ok := s.(cachedTagsService); ok {
+		return s, nil
+	}
+	cache, err := c.getResponseCache(cmd)
+	if err != nil || cache == nil {
+		return s, err
+	}
+	return cachedTagsService{s: s, cache: cache}, nil
+}
+
+type cachedProjectsService struct {
+	s     projectsService
+	cache *responseCache
+}
+
+func (s cachedProjectsService) List(ctx context.Context, o newreleases.ProjectListOptions) (projects []newreleases.Project, lastPage int, err error) {
+	p, err := cached(s.cache, func() (p cachePage[newreleases.Project], err error) {
+		p.Elements, p.LastPage, err = s.s.List(ctx, o)
+		return p, err
+	}, "projects.List", o)
+	return p.Elements, p.LastPage, err
+}
+
+func (s cachedProjectsService) Search(ctx context.Context, query, provider string) (projects []newreleases.Project, err error) {
+	return cached(s.cache, func() ([]newreleases.Project, error) {
+		return s.s.Search(ctx, query, provider)
+	}, "projects.Search", query, provider)
+}
+
+func (s cachedProjectsService) GetByID(ctx context.Context, id string) (project *newreleases.Project, err error) {
+	return cached(s.cache, func() (*newreleases.Project, error) {
+		return s.s.GetByID(ctx, id)
+	}, "projects.GetByID", id)
+}
+
+func (s cachedProjectsService) GetByName(ctx context.Context, provider, name string) (project *newreleases.Project, err error) {
+	return cached(s.cache, func() (*newreleases.Project, error) {
+		return s.s.GetByName(ctx, provider, name)
+	}, "projects.GetByName", provider, name)
+}
+
+func (s cachedProjectsService) Add(ctx context.Context, provider, name string, o *newreleases.ProjectOptions) (project *newreleases.Project, err error) {
+	err = s.cache.modify(func() (err error) {
+		project, err = s.s.Add(ctx, provider, name, o)
+		return err
+	})
+	return project, err
+}
+
+func (s cachedProjectsService) UpdateByID(ctx context.Context, id string, o *newreleases.ProjectOptions) (project *newreleases.Project, err error) {
+	err = s.cache.modify(func() (err error) {
+		project, err = s.s.UpdateByID(ctx, id, o)
+		return err
+	})
+	return project, err
+}
+
+func (s cachedProjectsService) UpdateByName(ctx context.Context, provider, name string, o *newreleases.ProjectOptions) (project *newreleases.Project, err error) {
+	err = s.cache.modify(func() (err error) {
+		project, err = s.s.UpdateByName(ctx, provider, name, o)
+		return err
+	})
+	return project, err
+}
+
+func (s cachedProjectsService) DeleteByID(ctx context.Context, id string) (err error) {
+	return s.cache.modify(func() error {
+		return s.s.DeleteByID(ctx, id)
+	})
+}
+
+func (s cachedProjectsService) DeleteByName(ctx context.Context, provider, name string) (err error) {
+	return s.cache.modify(func() error {
+		return s.s.DeleteByName(ctx, provider, name)
+	})
+}
+
+type cachedReleasesService struct {
+	s     releasesService
+	cache *responseCache
+}
+
+func (s cachedReleasesService) ListByProjectID(ctx context.Context, projectID string, page int) (releases []newreleases.Release, lastPage int, err error) {
+	p, err := cached(s.cache, func() (p cachePage[newreleases.Release], err error) {
+		p.Elements, p.LastPage, err = s.s.ListByProjectID(ctx, projectID, page)
+		return p, err
+	}, "releases.ListByProjectID", projectID, page)
+	return p.Elements, p.LastPage, err
+}
+
+func (s cachedReleasesService) ListByProjectName(ctx context.Context, provider, projectName string, page int) (releases []newreleases.Release, lastPage int, err error) {
+	p, err := cached(s.cache, func() (p cachePage[newreleases.Release], err error) {
+		p.Elements, p.LastPage, err = s.s.ListByProjectName(ctx, provider, projectName, page)
+		return p, err
+	}, "releases.ListByProjectName", provider, projectName, page)
+	return p.Elements, p.LastPage, err
+}
+
+func (s cachedReleasesService) GetByProjectID(ctx context.Context, projectID, version string) (release *newreleases.Release, err error) {
+	return cached(s.cache, func() (*newreleases.Release, error) {
+		return s.s.GetByProjectID(ctx, projectID, version)
+	}, "releases.GetByProjectID", projectID, version)
+}
+
+func (s cachedReleasesService) GetByProjectName(ctx context.Context, provider, projectName, version string) (release *newreleases.Release, err error) {
+	return cached(s.cache, func() (*newreleases.Release, error) {
+		return s.s.GetByProjectName(ctx, provider, projectName, version)
+	}, "releases.GetByProjectName", provider, projectName, version)
+}
+
+func (s cachedReleasesService) GetLatestByProjectID(ctx context.Context, projectID string) (release *newreleases.Release, err error) {
+	return cached(s.cache, func() (*newreleases.Release, error) {
+		return s.s.GetLatestByProjectID(ctx, projectID)
+	}, "releases.GetLatestByProjectID", projectID)
+}
+
+func (s cachedReleasesService) GetLatestByProjectName(ctx context.Context, provider, projectName string) (release *newreleases.Release, err error) {
+	return cached(s.cache, func() (*newreleases.Release, error) {
+		return s.s.GetLatestByProjectName(ctx, provider, projectName)
+	}, "releases.GetLatestByProjectName", provider, projectName)
+}
+
+func (s cachedReleasesService) GetNoteByProjectID(ctx context.Context, projectID string, version string) (note *newreleases.ReleaseNote, err error) {
+	return cached(s.cache, func() (*newreleases.ReleaseNote, error) {
+		return s.s.GetNoteByProjectID(ctx, projectID, version)
+	}, "releases.GetNoteByProjectID", projectID, version)
+}
+
+func (s cachedReleasesService) GetNoteByProjectName(ctx context.Context, provider, projectName string, version string) (note *newreleases.ReleaseNote, err error) {
+	return cached(s.cache, func() (*newreleases.ReleaseNote, error) {
+		return s.s.GetNoteByProjectName(ctx, provider, projectName, version)
+	}, "releases.GetNoteByProjectName", provider, projectName, version)
+}
+
+type cachedTagsService struct {
+	s     tagsService
+	cache *responseCache
+}
+
+func (s cachedTagsService) List(ctx context.Context) (tags []newreleases.Tag, err error) {
+	return cached(s.cache, func() ([]newreleases.Tag, error) {
+		return s.s.List(ctx)
+	}, "tags.List")
+}
+
+func (s cachedTagsService) Get(ctx context.Context, id string) (tag *newreleases.Tag, err error) {
+	return cached(s.cache, func() (*newreleases.Tag, error) {
+		return s.s.Get(ctx, id)
+	}, "tags.Get", id)
+}
+
+func (s cachedTagsService) Add(ctx context.Context, name string) (tag *newreleases.Tag, err error) {
+	err = s.cache.modify(func() (err error) {
+		tag, err = s.s.Add(ctx, name)
+		return err
+	})
+	return tag, err
+}
+
+func (s cachedTagsService) Update(ctx context.Context, id, name string) (tag *newreleases.Tag, err error) {
+	err = s.cache.modify(func() (err error) {
+		tag, err = s.s.Update(ctx, id, name)
+		return err
+	})
+	return tag, err
+}
+
+func (s cachedTagsService) Delete(ctx context.Context, id string) (err error) {
+	return s.cache.modify(func() error {
+		return s.s.Delete(ctx, id)
+	})
+}
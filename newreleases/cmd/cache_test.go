@@ -0,0 +1,225 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+func TestCache(t *testing.T) {
+	tags := []newreleases.Tag{
+		{ID: "33f1db7254b9", Name: "Awesome"},
+	}
+	wantOutput := "ID             NAME    \n" +
+		"33f1db7254b9   Awesome   \n"
+
+	cacheDir := t.TempDir()
+	s := &mockCountingTagsService{mockTagsService: newMockTagsService(tags, nil)}
+
+	run := func(t *testing.T, args ...string) (output string, err error) {
+		t.Helper()
+
+		var outputBuf bytes.Buffer
+		err = newCommand(t,
+			cmd.WithArgs(append([]string{"tag"}, args...)...),
+			cmd.WithOutput(&outputBuf),
+			cmd.WithCacheDir(cacheDir),
+			cmd.WithTagsService(s),
+		).Execute()
+		return outputBuf.String(), err
+	}
+
+	wantCalls := func(t *testing.T, want int) {
+		t.Helper()
+
+		if s.calls != want {
+			t.Errorf("got %v calls, want %v", s.calls, want)
+		}
+	}
+
+	t.Run("offline without cached response", func(t *testing.T) {
+		_, err := run(t, "list", "--offline")
+		wantError := "not available in offline mode: response of tags.List is not cached"
+		if err == nil || err.Error() != wantError {
+			t.Fatalf("got error %v, want %v", err, wantError)
+		}
+		wantCalls(t, 0)
+	})
+
+	t.Run("not cached by default", func(t *testing.T) {
+		if _, err := run(t, "list"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := run(t, "list", "--offline"); err == nil {
+			t.Fatal("got no error")
+		}
+		wantCalls(t, 1)
+	})
+
+	t.Run("cached", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			output, err := run(t, "list", "--cache-ttl", "1h")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if output != wantOutput {
+				t.Errorf("got output %q, want %q", output, wantOutput)
+			}
+		}
+		wantCalls(t, 2)
+	})
+
+	t.Run("no cache", func(t *testing.T) {
+		if _, err := run(t, "list", "--cache-ttl", "1h", "--no-cache"); err != nil {
+			t.Fatal(err)
+		}
+		wantCalls(t, 3)
+	})
+
+	t.Run("offline", func(t *testing.T) {
+		output, err := run(t, "list", "--offline")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if output != wantOutput {
+			t.Errorf("got output %q, want %q", output, wantOutput)
+		}
+		wantCalls(t, 3)
+	})
+
+	t.Run("offline and no cache", func(t *testing.T) {
+		_, err := run(t, "list", "--offline", "--no-cache")
+		wantError := "--offline and --no-cache can not be used together"
+		if err == nil || err.Error() != wantError {
+			t.Fatalf("got error %v, want %v", err, wantError)
+		}
+	})
+
+	t.Run("offline change", func(t *testing.T) {
+		_, err := run(t, "add", "Cool", "--offline")
+		wantError := "not available in offline mode"
+		if err == nil || err.Error() != wantError {
+			t.Fatalf("got error %v, want %v", err, wantError)
+		}
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		if _, err := run(t, "list", "--cache-ttl", "1ns"); err != nil {
+			t.Fatal(err)
+		}
+		wantCalls(t, 4)
+	})
+
+	t.Run("invalidated by change", func(t *testing.T) {
+		if _, err := run(t, "add", "Cool", "--cache-ttl", "1h"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := run(t, "list", "--offline"); err == nil {
+			t.Fatal("got no error")
+		}
+		if _, err := run(t, "list", "--cache-ttl", "1h"); err != nil {
+			t.Fatal(err)
+		}
+		wantCalls(t, 5)
+	})
+
+	t.Run("separated by API endpoint", func(t *testing.T) {
+		if _, err := run(t, "list", "--cache-ttl", "1h", "--api-endpoint", "https://api.example.com/v1/"); err != nil {
+			t.Fatal(err)
+		}
+		wantCalls(t, 6)
+		if _, err := run(t, "list", "--offline", "--api-endpoint", "https://api.example.org/v1/"); err == nil {
+			t.Fatal("got no error")
+		}
+		if _, err := run(t, "list", "--offline"); err != nil {
+			t.Fatal(err)
+		}
+		wantCalls(t, 6)
+	})
+}
+
+func TestCache_unwritableDir(t *testing.T) {
+	// A cache directory under a regular file can not be created.
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var outputBuf, errorOutputBuf bytes.Buffer
+	if err := newCommand(t,
+		cmd.WithArgs("tag", "list", "--cache-ttl", "1h"),
+		cmd.WithOutput(&outputBuf),
+		cmd.WithErrorOutput(&errorOutputBuf),
+		cmd.WithCacheDir(filepath.Join(file, "cache")),
+		cmd.WithTagsService(newMockTagsService([]newreleases.Tag{{ID: "33f1db7254b9", Name: "Awesome"}}, nil)),
+	).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	wantOutput := "ID             NAME    \n" +
+		"33f1db7254b9   Awesome   \n"
+	if gotOutput := outputBuf.String(); gotOutput != wantOutput {
+		t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+	}
+	if gotErrorOutput := errorOutputBuf.String(); !strings.HasPrefix(gotErrorOutput, "Response of tags.List is not cached: ") {
+		t.Errorf("got error output %q, want response not cached", gotErrorOutput)
+	}
+}
+
+func TestCache_pollingCommands(t *testing.T) {
+	s := &mockCountingReleasesService{
+		mockProjectReleasesService: newMockProjectReleasesService(map[string][]newreleases.Release{
+			"github golang/go": {
+				{Version: "1.22.0"},
+			},
+		}),
+	}
+	cacheDir := t.TempDir()
+
+	for i := 0; i < 2; i++ {
+		if err := newCommand(t,
+			cmd.WithArgs("release", "check", "github", "golang/go", "--current", "1.22.0", "--cache-ttl", "1h"),
+			cmd.WithOutput(new(bytes.Buffer)),
+			cmd.WithCacheDir(cacheDir),
+			cmd.WithReleasesService(s),
+		).Execute(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if s.calls != 2 {
+		t.Errorf("got %v calls, want %v", s.calls, 2)
+	}
+}
+
+// mockCountingTagsService counts calls to the List method.
+type mockCountingTagsService struct {
+	mockTagsService
+	calls int
+}
+
+func (s *mockCountingTagsService) List(ctx context.Context) ([]newreleases.Tag, error) {
+	s.calls++
+	return s.mockTagsService.List(ctx)
+}
+
+// mockCountingReleasesService counts calls to the ListByProjectName method.
+type mockCountingReleasesService struct {
+	mockProjectReleasesService
+	calls int
+}
+
+func (s *mockCountingReleasesService) ListByProjectName(ctx context.Context, provider, projectName string, page int) ([]newreleases.Release, int, error) {
+	s.calls++
+	return s.mockProjectReleasesService.ListByProjectName(ctx, provider, projectName, page)
+}
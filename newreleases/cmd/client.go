@@ -8,6 +8,7 @@ package cmd
 import (
 	"context"
 	"errors"
+	"net/http"
 	"net/url"
 	"time"
 
@@ -16,27 +17,52 @@ import (
 	"newreleases.io/newreleases"
 )
 
+// defaultTimeout is the default value of the timeout option.
+const defaultTimeout = 30 * time.Second
+
 func (c *command) getClient(cmd *cobra.Command) (client *newreleases.Client, err error) {
 	if c.client != nil {
 		return c.client, nil
 	}
 
-	authKey := c.config.GetString(optionNameAuthKey)
+	authKey, err := c.getAuthKey(cmd)
+	if err != nil {
+		return nil, err
+	}
 	if authKey == "" {
 		cmd.Println(configurationHelp)
 		cmd.Println()
 		return nil, errors.New("auth key not configured")
 	}
-	o, err := newClientOptions(cmd)
+	o, err := newClientOptions(cmd, c.config)
 	if err != nil {
 		return nil, err
 	}
+	if err := addCacheConfigOptions(cmd, c.config); err != nil {
+		return nil, err
+	}
+	if c.config.GetBool(optionNameOffline) {
+		o.HTTPClient = &http.Client{Transport: offlineTransport{}}
+	}
 	c.client = newreleases.NewClient(authKey, o)
 	return c.client, nil
 }
 
-func newClientOptions(cmd *cobra.Command) (o *newreleases.ClientOptions, err error) {
+// apiEndpoint returns the API endpoint from the flag or the configuration, or
+// an empty string if the default one should be used.
+func apiEndpoint(cmd *cobra.Command, config *viper.Viper) (endpoint string, err error) {
 	v, err := cmd.Flags().GetString(optionNameAPIEndpoint)
+	if err != nil {
+		return "", err
+	}
+	if v == "" {
+		v = config.GetString(optionNameAPIEndpoint)
+	}
+	return v, nil
+}
+
+func newClientOptions(cmd *cobra.Command, config *viper.Viper) (o *newreleases.ClientOptions, err error) {
+	v, err := apiEndpoint(cmd, config)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +73,15 @@ func newClientOptions(cmd *cobra.Command) (o *newreleases.ClientOptions, err err
 			return nil, err
 		}
 	}
-	return &newreleases.ClientOptions{BaseURL: baseURL}, nil
+	o = &newreleases.ClientOptions{BaseURL: baseURL}
+	p, err := newRetryPolicy(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if p.retries > 0 {
+		o.HTTPClient = &http.Client{Transport: &retryTransport{base: http.DefaultTransport, policy: p}}
+	}
+	return o, nil
 }
 
 func newClientContext(config *viper.Viper) (ctx context.Context, cancel context.CancelFunc) {
@@ -57,8 +91,14 @@ func newClientContext(config *viper.Viper) (ctx context.Context, cancel context.
 func addClientFlags(cmd *cobra.Command) (err error) {
 	flags := cmd.Flags()
 	flags.String(optionNameAuthKey, "", "API auth key")
-	flags.Duration(optionNameTimeout, 30*time.Second, "API request timeout")
+	flags.Duration(optionNameTimeout, defaultTimeout, "API request timeout")
 	flags.String(optionNameAPIEndpoint, "", "API Endpoint")
+	flags.Int(optionNameRetries, 0, "maximal number of retries of failed API requests, 0 to disable")
+	flags.Duration(optionNameRetryMaxWait, 30*time.Second, "maximal time to wait before retrying an API request")
+	flags.Bool(optionNameVerbose, false, "print retried API requests")
+	flags.Duration(optionNameCacheTTL, 0, "cache API responses for this duration, 0 to disable")
+	flags.Bool(optionNameNoCache, false, "do not use cached API responses")
+	flags.Bool(optionNameOffline, false, "use only cached API responses, regardless of their age")
 	if err := flags.MarkHidden(optionNameAPIEndpoint); err != nil {
 		return err
 	}
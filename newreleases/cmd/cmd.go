@@ -7,10 +7,12 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"text/template"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
@@ -22,6 +24,9 @@ const (
 	optionNameAuthKey     = "auth-key"
 	optionNameTimeout     = "timeout"
 	optionNameAPIEndpoint = "api-endpoint"
+	optionNameOutput      = "output"
+	optionNameProfile     = "profile"
+	optionNameProfiles    = "profiles"
 )
 
 func init() {
@@ -33,6 +38,12 @@ type command struct {
 	config                        *viper.Viper
 	client                        *newreleases.Client
 	cfgFile                       string
+	profile                       string
+	authKey                       string
+	output                        string
+	templateText                  string
+	templateFile                  string
+	template                      *template.Template
 	homeDir                       string
 	passwordReader                passwordReader
 	authKeysGetter                authKeysGetter
@@ -50,6 +61,10 @@ type command struct {
 	matrixRoomsService            matrixRoomsService
 	webhooksService               webhooksService
 	tagsService                   tagsService
+	sourceAccount                 *account
+	newAccount                    func(cmd *cobra.Command, config *viper.Viper, authKey string) (*account, error)
+	cacheDir                      string
+	responseCache                 *responseCache
 }
 
 type option func(*command)
@@ -65,8 +80,14 @@ More information at https://newreleases.io.`,
 			SilenceErrors: true,
 			SilenceUsage:  true,
 			PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-				cmdName := cmd.Name()
-				return c.initConfig(cmdName != cmdNameConfigure && cmdName != cmdNameGetAuthKey)
+				writesConfig := cmd.Annotations[annotationWritesConfig] == "true"
+				if err := c.initConfig(!writesConfig, writesConfig); err != nil {
+					return err
+				}
+				if err := validateOutputFormat(c.outputFormat()); err != nil {
+					return err
+				}
+				return c.initTemplate()
 			},
 		},
 	}
@@ -77,6 +98,9 @@ More information at https://newreleases.io.`,
 	if c.passwordReader == nil {
 		c.passwordReader = new(stdInPasswordReader)
 	}
+	if c.newAccount == nil {
+		c.newAccount = newAuthKeyAccount
+	}
 
 	c.initGlobalFlags()
 
@@ -120,6 +144,30 @@ More information at https://newreleases.io.`,
 	if err := c.initTagCmd(); err != nil {
 		return nil, err
 	}
+	if err := c.initImportCmd(); err != nil {
+		return nil, err
+	}
+	if err := c.initOutdatedCmd(); err != nil {
+		return nil, err
+	}
+	if err := c.initWatchCmd(); err != nil {
+		return nil, err
+	}
+	if err := c.initApplyCmd(); err != nil {
+		return nil, err
+	}
+	if err := c.initDiffCmd(); err != nil {
+		return nil, err
+	}
+	if err := c.initBackupCmd(); err != nil {
+		return nil, err
+	}
+	if err := c.initRestoreCmd(); err != nil {
+		return nil, err
+	}
+	if err := c.initConfigCmd(); err != nil {
+		return nil, err
+	}
 
 	c.initConfigureCmd()
 	if err := c.initGetAuthKeyCmd(); err != nil {
@@ -148,9 +196,13 @@ func Execute() error {
 func (c *command) initGlobalFlags() {
 	globalFlags := c.root.PersistentFlags()
 	globalFlags.StringVar(&c.cfgFile, "config", "", "config file (default is $HOME/.newreleases.yaml)")
+	globalFlags.StringVar(&c.profile, optionNameProfile, "", "configuration profile to use")
+	globalFlags.StringVarP(&c.output, optionNameOutput, "o", outputFormatTable, "output format: table, json, yaml")
+	globalFlags.StringVar(&c.templateText, optionNameTemplate, "", "Go template to format every listed or retrieved element")
+	globalFlags.StringVar(&c.templateFile, optionNameTemplateFile, "", "file with Go template to format every listed or retrieved element")
 }
 
-func (c *command) initConfig(requireConfigFileIfSet bool) (err error) {
+func (c *command) initConfig(requireConfigFileIfSet, createProfile bool) (err error) {
 	config := viper.New()
 	configName := ".newreleases"
 	if c.cfgFile != "" {
@@ -186,10 +238,49 @@ func (c *command) initConfig(requireConfigFileIfSet bool) (err error) {
 			return err
 		}
 	}
+
+	// Options of the profile override the ones at the top level of the config
+	// file, but not the ones from flags and environment variables.
+	if c.profile == "" {
+		c.profile = config.GetString(optionNameProfile)
+	}
+	if c.profile != "" {
+		if err := mergeProfile(config, c.profile, createProfile); err != nil {
+			return err
+		}
+	}
+
 	c.config = config
 	return nil
 }
 
+// mergeProfile merges options of the named profile into the top level
+// options of the config.
+func mergeProfile(config *viper.Viper, profile string, createProfile bool) (err error) {
+	if strings.Contains(profile, ".") {
+		return fmt.Errorf("invalid profile name %q", profile)
+	}
+	key := optionNameProfiles + "." + profile
+	if !config.IsSet(key) && !createProfile {
+		return fmt.Errorf("profile %q not found in the configuration", profile)
+	}
+	return config.MergeConfigMap(config.GetStringMap(key))
+}
+
+// profileConfig returns options of the named profile from the config file,
+// independently of the selected profile.
+func (c *command) profileConfig(profile string) (config *viper.Viper, err error) {
+	config = viper.New()
+	config.SetConfigFile(c.cfgFile)
+	if err := config.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	if err := mergeProfile(config, profile, false); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
 func (c *command) setHomeDir() (err error) {
 	if c.homeDir != "" {
 		return
@@ -203,6 +294,9 @@ func (c *command) setHomeDir() (err error) {
 }
 
 func (c *command) writeConfig(cmd *cobra.Command, authKey string) (err error) {
+	if c.profile != "" {
+		return c.writeProfileConfig(cmd, authKey)
+	}
 	c.config.Set(optionNameAuthKey, strings.TrimSpace(authKey))
 	err = c.config.WriteConfig()
 	if _, ok := err.(viper.ConfigFileNotFoundError); ok {
@@ -211,6 +305,27 @@ func (c *command) writeConfig(cmd *cobra.Command, authKey string) (err error) {
 	return err
 }
 
+// writeProfileConfig sets the auth key, and the API endpoint if it is set by
+// the flag, of the profile in the config file, leaving all other options as
+// they are in the file.
+func (c *command) writeProfileConfig(cmd *cobra.Command, authKey string) (err error) {
+	return c.updateConfigFile(func(settings map[string]interface{}) error {
+		settings[optionNameAuthKey] = strings.TrimSpace(authKey)
+		if f := cmd.Flags().Lookup(optionNameAPIEndpoint); f != nil && f.Changed {
+			settings[optionNameAPIEndpoint] = f.Value.String()
+		}
+		return nil
+	})
+}
+
+func (c *command) printConfigSaved(cmd *cobra.Command) {
+	if c.profile != "" {
+		cmd.Printf("Configuration of profile %s saved to: %s.\n", c.profile, c.cfgFile)
+		return
+	}
+	cmd.Printf("Configuration saved to: %s.\n", c.cfgFile)
+}
+
 func newTable(w io.Writer) (table *tablewriter.Table) {
 	table = tablewriter.NewWriter(w)
 	table.SetAutoWrapText(false)
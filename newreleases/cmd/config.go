@@ -0,0 +1,306 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// annotationWritesConfig marks commands that write the config file, for which
+// the file and the selected profile do not have to exist.
+const annotationWritesConfig = "writes-config"
+
+// configOption is an option that can be managed with the config command.
+type configOption struct {
+	name     string
+	secret   bool
+	validate func(value string) error
+}
+
+var configOptions = []configOption{
+	{name: optionNameAuthKey, secret: true},
+	{name: optionNameAuthKeyCommand},
+	{name: optionNameAuthKeyStoreCommand},
+	{name: optionNameAuthKeyFile},
+	{name: optionNameTimeout, validate: validateDuration},
+	{name: optionNameAPIEndpoint, validate: validateURL},
+	{name: optionNameOutput, validate: validateOutputFormat},
+	{name: optionNameProfile},
+	{name: optionNameCacheTTL, validate: validateDuration},
+	{name: optionNameOffline, validate: validateBool},
+	{name: optionNameWebhookSecret, secret: true},
+	{name: optionNameCredentialStore, validate: validateBool},
+	{name: optionNameCredentialName},
+}
+
+func findConfigOption(name string) (o configOption, err error) {
+	for _, o := range configOptions {
+		if o.name == name {
+			return o, nil
+		}
+	}
+	names := make([]string, 0, len(configOptions))
+	for _, o := range configOptions {
+		names = append(names, o.name)
+	}
+	return o, fmt.Errorf("unknown option %q, supported options are: %s", name, strings.Join(names, ", "))
+}
+
+func validateDuration(value string) (err error) {
+	if _, err := time.ParseDuration(value); err != nil {
+		return fmt.Errorf("invalid duration %q", value)
+	}
+	return nil
+}
+
+func validateURL(value string) (err error) {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("invalid URL %q", value)
+	}
+	return nil
+}
+
+func validateBool(value string) (err error) {
+	if _, err := strconv.ParseBool(value); err != nil {
+		return fmt.Errorf("invalid boolean %q", value)
+	}
+	return nil
+}
+
+// configSetting is an option with its value, as listed by the config list
+// command.
+type configSetting struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func (c *command) initConfigCmd() (err error) {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Get and set configuration options",
+		Long: `Get and set configuration options.
+
+Options are set in the config file, or in the profile of the config file if
+the profile is selected. Supported options are auth-key, auth-key-command,
+auth-key-store-command, auth-key-file, timeout, api-endpoint, output,
+profile, cache-ttl, offline, webhook-secret, credential-store and
+credential-name.`,
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "get KEY",
+		Short: "Print the value of an option",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if _, err := findConfigOption(args[0]); err != nil {
+				return err
+			}
+			if !c.config.IsSet(args[0]) {
+				return fmt.Errorf("option %q is not set", args[0])
+			}
+			cmd.Println(c.config.GetString(args[0]))
+			return nil
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:         "set KEY VALUE",
+		Short:       "Set the value of an option in the config file",
+		Args:        cobra.ExactArgs(2),
+		Annotations: map[string]string{annotationWritesConfig: "true"},
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			o, err := findConfigOption(args[0])
+			if err != nil {
+				return err
+			}
+			if o.name == optionNameProfile && c.profile != "" {
+				return errors.New("profile can not be set in a profile")
+			}
+			if o.validate != nil {
+				if err := o.validate(args[1]); err != nil {
+					return err
+				}
+			}
+			if err := c.updateConfigFile(func(settings map[string]interface{}) error {
+				settings[o.name] = args[1]
+				return nil
+			}); err != nil {
+				return err
+			}
+			c.printConfigSaved(cmd)
+			return nil
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:         "unset KEY",
+		Short:       "Remove an option from the config file",
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{annotationWritesConfig: "true"},
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			o, err := findConfigOption(args[0])
+			if err != nil {
+				return err
+			}
+			if err := c.updateConfigFile(func(settings map[string]interface{}) error {
+				if _, ok := settings[o.name]; !ok {
+					return fmt.Errorf("option %q is not set in the config file", o.name)
+				}
+				delete(settings, o.name)
+				return nil
+			}); err != nil {
+				return err
+			}
+			c.printConfigSaved(cmd)
+			return nil
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "list",
+		Short: "List options that are set, with masked secrets",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			settings := make([]configSetting, 0)
+			for _, o := range configOptions {
+				if !c.config.IsSet(o.name) {
+					continue
+				}
+				value := c.config.GetString(o.name)
+				if o.secret {
+					value = maskSecret(value)
+				}
+				settings = append(settings, configSetting{Key: o.name, Value: value})
+			}
+
+			if ok, err := c.writeOutput(cmd, settings); ok || err != nil {
+				return err
+			}
+
+			if len(settings) == 0 {
+				cmd.Println("No options set.")
+				return nil
+			}
+
+			table := newTable(cmd.OutOrStdout())
+			table.SetHeader([]string{"Key", "Value"})
+			for _, s := range settings {
+				table.Append([]string{s.Key, s.Value})
+			}
+			table.Render()
+			return nil
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "path",
+		Short: "Print the path of the config file",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			cmd.Println(c.cfgFile)
+			return nil
+		},
+	})
+
+	validateCmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate options and check that the auth key works",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var errs []error
+			for _, o := range configOptions {
+				if o.validate == nil || !c.config.IsSet(o.name) {
+					continue
+				}
+				if err := o.validate(c.config.GetString(o.name)); err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", o.name, err))
+				}
+			}
+			if err := errors.Join(errs...); err != nil {
+				return err
+			}
+
+			ctx, cancel := newClientContext(c.config)
+			defer cancel()
+
+			if _, err := c.authService.List(ctx); err != nil {
+				return fmt.Errorf("auth key: %w", err)
+			}
+
+			cmd.Println("Configuration is valid.")
+			return nil
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := addClientConfigOptions(cmd, c.config); err != nil {
+				return err
+			}
+			return c.setAuthService(cmd, args)
+		},
+	}
+	cmd.AddCommand(validateCmd)
+
+	c.root.AddCommand(cmd)
+	return addClientFlags(validateCmd)
+}
+
+// updateConfigFile reads options only from the config file, without the ones
+// from flags and environment variables, changes options of the selected
+// profile, or the top level ones if no profile is selected, and writes them
+// back to the file.
+func (c *command) updateConfigFile(update func(settings map[string]interface{}) error) (err error) {
+	config := viper.New()
+	config.SetConfigFile(c.cfgFile)
+	if err := config.ReadInConfig(); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	all := config.AllSettings()
+	settings := all
+	if c.profile != "" {
+		profiles, _ := all[optionNameProfiles].(map[string]interface{})
+		if profiles == nil {
+			profiles = make(map[string]interface{})
+			all[optionNameProfiles] = profiles
+		}
+		// Keys of all settings are lowercased, as profile names are
+		// case-insensitive.
+		profile := strings.ToLower(c.profile)
+		settings, _ = profiles[profile].(map[string]interface{})
+		if settings == nil {
+			settings = make(map[string]interface{})
+			profiles[profile] = settings
+		}
+	}
+	if err := update(settings); err != nil {
+		return err
+	}
+
+	config = viper.New()
+	config.SetConfigFile(c.cfgFile)
+	if err := config.MergeConfigMap(all); err != nil {
+		return err
+	}
+	return config.WriteConfigAs(c.cfgFile)
+}
+
+// maskSecret replaces all but the last four characters of the secret with
+// asterisks, or all of them if the secret is short.
+func maskSecret(s string) string {
+	if len(s) <= 8 {
+		return strings.Repeat("*", len(s))
+	}
+	return strings.Repeat("*", len(s)-4) + s[len(s)-4:]
+}
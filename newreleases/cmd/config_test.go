@@ -0,0 +1,198 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+func TestConfigCmd(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, ".newreleases.yaml")
+
+	run := func(t *testing.T, authService cmd.AuthService, args ...string) (output string, err error) {
+		t.Helper()
+
+		var outputBuf bytes.Buffer
+		opts := []cmd.Option{
+			cmd.WithHomeDir(dir),
+			cmd.WithArgs(append([]string{"config"}, args...)...),
+			cmd.WithOutput(&outputBuf),
+		}
+		if authService != nil {
+			opts = append(opts, cmd.WithAuthService(authService))
+		}
+		err = newCommand(t, opts...).Execute()
+		return outputBuf.String(), err
+	}
+
+	for _, tc := range []struct {
+		name        string
+		args        []string
+		authService cmd.AuthService
+		wantOutput  string
+		wantError   string
+		wantData    string
+	}{
+		{
+			name:       "path",
+			args:       []string{"path"},
+			wantOutput: cfgFile + "\n",
+		},
+		{
+			name:       "list empty",
+			args:       []string{"list"},
+			wantOutput: "No options set.\n",
+		},
+		{
+			name:       "set auth key",
+			args:       []string{"set", "auth-key", "z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71"},
+			wantOutput: fmt.Sprintf("Configuration saved to: %s.\n", cfgFile),
+			wantData:   "auth-key: z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n",
+		},
+		{
+			name:       "set timeout",
+			args:       []string{"set", "timeout", "1m"},
+			wantOutput: fmt.Sprintf("Configuration saved to: %s.\n", cfgFile),
+			wantData:   "auth-key: z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\ntimeout: 1m\n",
+		},
+		{
+			name:      "set invalid timeout",
+			args:      []string{"set", "timeout", "soon"},
+			wantError: `invalid duration "soon"`,
+			wantData:  "auth-key: z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\ntimeout: 1m\n",
+		},
+		{
+			name:      "set unknown option",
+			args:      []string{"set", "color", "blue"},
+			wantError: `unknown option "color", supported options are: auth-key, auth-key-command, auth-key-store-command, auth-key-file, timeout, api-endpoint, output, profile, cache-ttl, offline, webhook-secret, credential-store, credential-name`,
+		},
+		{
+			name:       "set profile auth key",
+			args:       []string{"set", "auth-key", "9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71", "--profile", "ci"},
+			wantOutput: fmt.Sprintf("Configuration of profile ci saved to: %s.\n", cfgFile),
+			wantData: "auth-key: z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n" +
+				"profiles:\n" +
+				"    ci:\n" +
+				"        auth-key: 9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71\n" +
+				"timeout: 1m\n",
+		},
+		{
+			name:       "get",
+			args:       []string{"get", "timeout"},
+			wantOutput: "1m\n",
+		},
+		{
+			name:       "get from profile",
+			args:       []string{"get", "auth-key", "--profile", "ci"},
+			wantOutput: "9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71\n",
+		},
+		{
+			name:      "get not set",
+			args:      []string{"get", "api-endpoint"},
+			wantError: `option "api-endpoint" is not set`,
+		},
+		{
+			name: "list",
+			args: []string{"list"},
+			wantOutput: "KEY        VALUE                                \n" +
+				"auth-key   ********************************cw71   \n" +
+				"timeout    1m                                     \n",
+		},
+		{
+			name:       "list json",
+			args:       []string{"list", "--output", "json"},
+			wantOutput: "[\n  {\n    \"key\": \"auth-key\",\n    \"value\": \"********************************cw71\"\n  },\n  {\n    \"key\": \"timeout\",\n    \"value\": \"1m\"\n  }\n]\n",
+		},
+		{
+			name:        "validate",
+			args:        []string{"validate"},
+			authService: newMockAuthService(nil, nil),
+			wantOutput:  "Configuration is valid.\n",
+		},
+		{
+			name:        "validate unauthorized",
+			args:        []string{"validate"},
+			authService: newMockAuthService(nil, newreleases.ErrUnauthorized),
+			wantError:   "auth key: unauthorized",
+		},
+		{
+			name:       "unset",
+			args:       []string{"unset", "timeout"},
+			wantOutput: fmt.Sprintf("Configuration saved to: %s.\n", cfgFile),
+			wantData: "auth-key: z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n" +
+				"profiles:\n" +
+				"    ci:\n" +
+				"        auth-key: 9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71\n",
+		},
+		{
+			name:      "unset not set",
+			args:      []string{"unset", "timeout"},
+			wantError: `option "timeout" is not set in the config file`,
+		},
+		{
+			name:      "unset in new profile",
+			args:      []string{"unset", "timeout", "--profile", "new"},
+			wantError: `option "timeout" is not set in the config file`,
+			wantData: "auth-key: z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n" +
+				"profiles:\n" +
+				"    ci:\n" +
+				"        auth-key: 9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71\n",
+		},
+		{
+			name:       "set in mixed case profile",
+			args:       []string{"set", "timeout", "2m", "--profile", "CI"},
+			wantOutput: fmt.Sprintf("Configuration of profile CI saved to: %s.\n", cfgFile),
+			wantData: "auth-key: z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n" +
+				"profiles:\n" +
+				"    ci:\n" +
+				"        auth-key: 9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71\n" +
+				"        timeout: 2m\n",
+		},
+		{
+			name:       "unset in mixed case profile",
+			args:       []string{"unset", "timeout", "--profile", "Ci"},
+			wantOutput: fmt.Sprintf("Configuration of profile Ci saved to: %s.\n", cfgFile),
+			wantData: "auth-key: z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n" +
+				"profiles:\n" +
+				"    ci:\n" +
+				"        auth-key: 9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			output, err := run(t, tc.authService, tc.args...)
+			if tc.wantError != "" {
+				if err == nil || err.Error() != tc.wantError {
+					t.Fatalf("got error %v, want %v", err, tc.wantError)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if output != tc.wantOutput {
+				t.Errorf("got output %q, want %q", output, tc.wantOutput)
+			}
+
+			if tc.wantData != "" {
+				gotData, err := os.ReadFile(cfgFile)
+				if err != nil && !errors.Is(err, os.ErrNotExist) {
+					t.Fatal(err)
+				}
+				if string(gotData) != tc.wantData {
+					t.Errorf("got config file data %q, want %q", string(gotData), tc.wantData)
+				}
+			}
+		})
+	}
+}
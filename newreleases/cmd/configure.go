@@ -18,6 +18,9 @@ func (c *command) initConfigureCmd() {
 		Use:   cmdNameConfigure,
 		Short: "Provide configuration values to be stored in a file",
 		Long:  configurationHelp,
+		Annotations: map[string]string{
+			annotationWritesConfig: "true",
+		},
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			reader := bufio.NewReader(cmd.InOrStdin())
 
@@ -31,12 +34,7 @@ func (c *command) initConfigureCmd() {
 				return nil
 			}
 
-			if err := c.writeConfig(cmd, authKey); err != nil {
-				return err
-			}
-
-			cmd.Printf("Configuration saved to: %s.\n", c.cfgFile)
-			return nil
+			return c.saveAuthKey(cmd, authKey)
 		},
 	})
 }
@@ -8,6 +8,7 @@ package cmd_test
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -181,3 +182,66 @@ func TestConfigureCmd_overwrite(t *testing.T) {
 	// overwrite with the new key
 	testConfigre(t, "9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71")
 }
+
+func TestConfigureCmd_profile(t *testing.T) {
+	dir := t.TempDir()
+
+	cfgFile := filepath.Join(dir, ".newreleases.yaml")
+	if err := os.WriteFile(cfgFile, []byte("auth-key: z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var outputBuf bytes.Buffer
+	if err := newCommand(t,
+		cmd.WithCfgFile(cfgFile),
+		cmd.WithHomeDir(dir),
+		cmd.WithArgs("configure", "--profile", "team"),
+		cmd.WithOutput(&outputBuf),
+		cmd.WithInput(strings.NewReader("9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71\n")),
+	).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	gotOutput := outputBuf.String()
+	wantOutput := fmt.Sprintf("Auth Key: Configuration of profile team saved to: %s.\n", cfgFile)
+	if gotOutput != wantOutput {
+		t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+	}
+
+	gotData, err := os.ReadFile(cfgFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantData := "auth-key: z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n" +
+		"profiles:\n" +
+		"    team:\n" +
+		"        auth-key: 9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71\n"
+	if string(gotData) != wantData {
+		t.Errorf("got config file data %q, want %q", string(gotData), wantData)
+	}
+
+	t.Run("use profile", func(t *testing.T) {
+		if err := newCommand(t,
+			cmd.WithHomeDir(dir),
+			cmd.WithArgs("tag", "list", "--profile", "team"),
+			cmd.WithOutput(io.Discard),
+			cmd.WithTagsService(newMockTagsService(nil, nil)),
+		).Execute(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("profile not found", func(t *testing.T) {
+		t.Setenv("NEWRELEASES_PROFILE", "ci")
+
+		err := newCommand(t,
+			cmd.WithHomeDir(dir),
+			cmd.WithArgs("tag", "list"),
+			cmd.WithTagsService(newMockTagsService(nil, nil)),
+		).Execute()
+		wantError := `profile "ci" not found in the configuration`
+		if err == nil || err.Error() != wantError {
+			t.Fatalf("got error %v, want %v", err, wantError)
+		}
+	})
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	optionNameCredentialStore      = "credential-store"
+	optionNameCredentialName       = "credential-name"
+	optionNameCredentialPassphrase = "credential-passphrase"
+
+	defaultCredentialName = "default"
+	credentialsFileName   = ".newreleases-credentials.json"
+
+	credentialsVersion    = 1
+	credentialsIterations = 600000
+)
+
+var errInvalidPassphrase = errors.New("invalid credential store passphrase")
+
+// credentials is the content of the encrypted credential store file. Auth
+// keys are encrypted with AES-256-GCM, with the key derived from the
+// passphrase by PBKDF2 with SHA-256, and with their names as additional
+// authenticated data, so that they can not be swapped.
+type credentials struct {
+	Version    int                            `json:"version"`
+	Iterations int                            `json:"iterations"`
+	Salt       []byte                         `json:"salt"`
+	Keys       map[string]encryptedCredential `json:"keys"`
+}
+
+type encryptedCredential struct {
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// credentialStore holds the decrypted auth keys of the credential store file.
+type credentialStore struct {
+	filename string
+	c        credentials
+	aead     cipher.AEAD
+}
+
+// credentialsFilename returns the path to the credential store file, which is
+// in the same directory as the config file.
+func (c *command) credentialsFilename() string {
+	return filepath.Join(filepath.Dir(c.cfgFile), credentialsFileName)
+}
+
+// credentialName returns the name of the auth key in the credential store.
+func (c *command) credentialName() string {
+	if name := c.config.GetString(optionNameCredentialName); name != "" {
+		return name
+	}
+	return defaultCredentialName
+}
+
+// openCredentialStore reads the credential store file and derives the
+// encryption key from the passphrase, that is set by the
+// credential-passphrase option, or read from the terminal. If the file does
+// not exist and create is true, a new store is returned, asking for the new
+// passphrase twice.
+func (c *command) openCredentialStore(cmd *cobra.Command, create bool) (s *credentialStore, err error) {
+	s = &credentialStore{filename: c.credentialsFilename()}
+
+	data, err := os.ReadFile(s.filename)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &s.c); err != nil {
+			return nil, fmt.Errorf("credential store %s: %w", s.filename, err)
+		}
+		if s.c.Version != credentialsVersion {
+			return nil, fmt.Errorf("credential store %s: unsupported version %v", s.filename, s.c.Version)
+		}
+		if s.c.Keys == nil {
+			s.c.Keys = make(map[string]encryptedCredential)
+		}
+	case os.IsNotExist(err) && create:
+		s.c = credentials{
+			Version:    credentialsVersion,
+			Iterations: credentialsIterations,
+			Salt:       make([]byte, 16),
+			Keys:       make(map[string]encryptedCredential),
+		}
+		if _, err := rand.Read(s.c.Salt); err != nil {
+			return nil, err
+		}
+	case os.IsNotExist(err):
+		return nil, fmt.Errorf("credential store %s does not exist", s.filename)
+	default:
+		return nil, err
+	}
+
+	passphrase := c.config.GetString(optionNameCredentialPassphrase)
+	if passphrase == "" {
+		passphrase, err = terminalPromptPassword(cmd, c.passwordReader, "Credential store passphrase")
+		if err != nil {
+			return nil, err
+		}
+		if len(s.c.Keys) == 0 {
+			repeated, err := terminalPromptPassword(cmd, c.passwordReader, "Repeat passphrase")
+			if err != nil {
+				return nil, err
+			}
+			if repeated != passphrase {
+				return nil, errors.New("passphrases do not match")
+			}
+		}
+	}
+	if passphrase == "" {
+		return nil, errors.New("credential store passphrase not provided")
+	}
+
+	key, err := pbkdf2.Key(sha256.New, passphrase, s.c.Salt, s.c.Iterations, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	s.aead, err = cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the passphrase with any of the stored keys, so that keys
+	// encrypted with different passphrases are not mixed.
+	for name := range s.c.Keys {
+		if _, err := s.get(name); err != nil {
+			return nil, err
+		}
+		break
+	}
+	return s, nil
+}
+
+// get returns the decrypted auth key with the name.
+func (s *credentialStore) get(name string) (authKey string, err error) {
+	e, ok := s.c.Keys[name]
+	if !ok {
+		return "", fmt.Errorf("auth key %q not found in the credential store %s", name, s.filename)
+	}
+	plaintext, err := s.aead.Open(nil, e.Nonce, e.Ciphertext, []byte(name))
+	if err != nil {
+		return "", errInvalidPassphrase
+	}
+	return string(plaintext), nil
+}
+
+// set encrypts the auth key with the name and saves the credential store
+// file.
+func (s *credentialStore) set(name, authKey string) (err error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	s.c.Keys[name] = encryptedCredential{
+		Nonce:      nonce,
+		Ciphertext: s.aead.Seal(nil, nonce, []byte(authKey), []byte(name)),
+	}
+
+	data, err := json.MarshalIndent(s.c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.filename, append(data, '\n'), 0o600)
+}
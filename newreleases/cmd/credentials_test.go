@@ -0,0 +1,180 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+)
+
+func TestCredentialStore(t *testing.T) {
+	dir := t.TempDir()
+	cfgFile := filepath.Join(dir, ".newreleases.yaml")
+	credentialsFile := filepath.Join(dir, ".newreleases-credentials.json")
+	config := "credential-store: true\n" +
+		"profiles:\n" +
+		"    work:\n" +
+		"        credential-name: work\n"
+	if err := os.WriteFile(cfgFile, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	defaultKey := "z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71"
+	workKey := "9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71"
+
+	configure := func(t *testing.T, passphrase, authKey string, args ...string) (output string, err error) {
+		t.Helper()
+
+		var outputBuf bytes.Buffer
+		err = newCommand(t,
+			cmd.WithHomeDir(dir),
+			cmd.WithArgs(append([]string{"configure"}, args...)...),
+			cmd.WithOutput(&outputBuf),
+			cmd.WithInput(strings.NewReader(authKey+"\n")),
+			cmd.WithPasswordReader(newMockPasswordReader(passphrase, nil)),
+		).Execute()
+		return outputBuf.String(), err
+	}
+
+	t.Run("configure new store", func(t *testing.T) {
+		output, err := configure(t, "correct horse", defaultKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantOutput := fmt.Sprintf("Auth Key: Credential store passphrase: \nRepeat passphrase: \nAuth key \"default\" saved to the credential store: %s.\n", credentialsFile)
+		if output != wantOutput {
+			t.Errorf("got output %q, want %q", output, wantOutput)
+		}
+
+		info, err := os.Stat(credentialsFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mode := info.Mode().Perm(); mode != 0o600 {
+			t.Errorf("got credential store file mode %v, want %v", mode, os.FileMode(0o600))
+		}
+		data, err := os.ReadFile(credentialsFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Contains(data, []byte(defaultKey)) {
+			t.Error("credential store contains the plain text auth key")
+		}
+	})
+
+	t.Run("configure with invalid passphrase", func(t *testing.T) {
+		if _, err := configure(t, "battery staple", workKey, "--profile", "work"); err == nil || err.Error() != "invalid credential store passphrase" {
+			t.Fatalf("got error %v, want invalid credential store passphrase", err)
+		}
+	})
+
+	t.Run("configure profile", func(t *testing.T) {
+		output, err := configure(t, "correct horse", workKey, "--profile", "work")
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantOutput := fmt.Sprintf("Auth Key: Credential store passphrase: \nAuth key \"work\" saved to the credential store: %s.\n", credentialsFile)
+		if output != wantOutput {
+			t.Errorf("got output %q, want %q", output, wantOutput)
+		}
+	})
+
+	gotConfig, err := os.ReadFile(cfgFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(gotConfig) != config {
+		t.Errorf("got config file data %q, want %q", gotConfig, config)
+	}
+
+	for _, tc := range []struct {
+		name       string
+		args       []string
+		passphrase string
+		env        map[string]string
+		wantError  string
+	}{
+		{
+			name:       "default key",
+			passphrase: "correct horse",
+			wantError:  "not available in offline mode: response of tags.List is not cached",
+		},
+		{
+			name:       "profile key",
+			args:       []string{"--profile", "work"},
+			passphrase: "correct horse",
+			wantError:  "not available in offline mode: response of tags.List is not cached",
+		},
+		{
+			name:      "passphrase from environment",
+			env:       map[string]string{"NEWRELEASES_CREDENTIAL_PASSPHRASE": "correct horse"},
+			wantError: "not available in offline mode: response of tags.List is not cached",
+		},
+		{
+			name:       "invalid passphrase",
+			passphrase: "battery staple",
+			wantError:  "invalid credential store passphrase",
+		},
+		{
+			name:       "missing key",
+			env:        map[string]string{"NEWRELEASES_CREDENTIAL_NAME": "personal"},
+			passphrase: "correct horse",
+			wantError:  fmt.Sprintf("auth key %q not found in the credential store %s", "personal", credentialsFile),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
+			err := newCommand(t,
+				cmd.WithHomeDir(dir),
+				cmd.WithCacheDir(t.TempDir()),
+				cmd.WithArgs(append([]string{"tag", "list", "--offline"}, tc.args...)...),
+				cmd.WithOutput(new(bytes.Buffer)),
+				cmd.WithErrorOutput(new(bytes.Buffer)),
+				cmd.WithPasswordReader(newMockPasswordReader(tc.passphrase, nil)),
+			).Execute()
+			if err == nil || err.Error() != tc.wantError {
+				t.Fatalf("got error %v, want %v", err, tc.wantError)
+			}
+		})
+	}
+}
+
+func TestCredentialStore_withoutKeys(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ".newreleases.yaml"), []byte("credential-store: true\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	credentialsFile := filepath.Join(dir, ".newreleases-credentials.json")
+	data := `{"version": 1, "iterations": 1000, "salt": "c2FsdHNhbHRzYWx0c2FsdA==", "keys": null}`
+	if err := os.WriteFile(credentialsFile, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	var outputBuf bytes.Buffer
+	if err := newCommand(t,
+		cmd.WithHomeDir(dir),
+		cmd.WithArgs("configure"),
+		cmd.WithOutput(&outputBuf),
+		cmd.WithInput(strings.NewReader("z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n")),
+		cmd.WithPasswordReader(newMockPasswordReader("correct horse", nil)),
+	).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	wantOutput := fmt.Sprintf("Auth Key: Credential store passphrase: \nRepeat passphrase: \nAuth key \"default\" saved to the credential store: %s.\n", credentialsFile)
+	if gotOutput := outputBuf.String(); gotOutput != wantOutput {
+		t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+	}
+}
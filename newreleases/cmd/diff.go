@@ -0,0 +1,98 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initDiffCmd() (err error) {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show differences between tracked projects and a manifest file",
+		Long: `Show differences between tracked projects and a manifest file.
+
+The manifest file has the same format as for the apply command. Differences
+are printed for every project option in the unified diff format, where lines
+starting with "-" are the current options of tracked projects and lines
+starting with "+" are the options from the manifest. Projects that are tracked,
+but not listed in the manifest are differences only with the --prune flag, as
+they are removed by the apply command only with the same flag.
+
+The exit code is 0 if tracked projects match the manifest, 2 if there are
+differences and 1 on any error.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			flags := cmd.Flags()
+			file, err := flags.GetString(optionNameFile)
+			if err != nil {
+				return err
+			}
+			prune, err := flags.GetBool(optionNamePrune)
+			if err != nil {
+				return err
+			}
+
+			m, err := readManifestFile(file)
+			if err != nil {
+				return err
+			}
+
+			plan, err := c.newProjectsPlan(m, prune)
+			if err != nil {
+				return err
+			}
+
+			if len(plan) == 0 {
+				cmd.Println("No differences. Tracked projects match the manifest.")
+				return nil
+			}
+
+			printDiff(cmd, plan, file)
+			return &ExitError{Code: exitCodeDrift}
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := addClientConfigOptions(cmd, c.config); err != nil {
+				return err
+			}
+			return c.setProjectsService(cmd, args)
+		},
+	}
+
+	cmd.Flags().StringP(optionNameFile, "f", "", "manifest file")
+	cmd.Flags().Bool(optionNamePrune, false, "include tracked projects that are not in the manifest")
+	if err := cmd.MarkFlagRequired(optionNameFile); err != nil {
+		return err
+	}
+
+	c.root.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+func printDiff(cmd *cobra.Command, plan []planEntry, file string) {
+	cmd.Println("--- tracked projects")
+	cmd.Printf("+++ %s\n", file)
+	for _, e := range plan {
+		p := e.project
+		switch e.action {
+		case planActionAdd:
+			cmd.Printf("@@ %s %s (not tracked) @@\n", p.Provider, p.Name)
+			for _, f := range p.fields() {
+				cmd.Printf("+%s: %s\n", f.name, f.value)
+			}
+		case planActionUpdate:
+			cmd.Printf("@@ %s %s @@\n", p.Provider, p.Name)
+			for _, c := range e.changes {
+				cmd.Printf("-%s: %s\n", c.name, c.from)
+				cmd.Printf("+%s: %s\n", c.name, c.to)
+			}
+		case planActionRemove:
+			cmd.Printf("@@ %s %s (not in manifest) @@\n", p.Provider, p.Name)
+			for _, f := range p.fields() {
+				cmd.Printf("-%s: %s\n", f.name, f.value)
+			}
+		}
+	}
+}
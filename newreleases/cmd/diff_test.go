@@ -0,0 +1,121 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+func TestDiffCmd(t *testing.T) {
+	projects := []newreleases.Project{
+		{
+			ID:                 "mdsbe60td5gwgzetyksdfeyxt4",
+			Name:               "golang/go",
+			Provider:           "github",
+			EmailNotification:  newreleases.EmailNotificationDaily,
+			Exclusions:         []newreleases.Exclusion{{Value: `^0\.1`, Inverse: true}},
+			ExcludePrereleases: true,
+			TagIDs:             []string{"1d33b7254b9f", "33f1db7254b9"},
+		},
+		{ID: "ksdfeyxt4mdsbe60td5gwgzety", Name: "django", Provider: "pypi"},
+	}
+
+	for _, tc := range []struct {
+		name         string
+		manifest     string
+		args         []string
+		wantOutput   string
+		wantExitCode int
+	}{
+		{
+			name: "no differences",
+			manifest: `projects:
+  - provider: github
+    name: golang/go
+    email: daily
+    regex-exclude:
+      - value: ^0\.1
+        inverse: true
+    exclude-prereleases: true
+    tags: [33f1db7254b9, 1d33b7254b9f]
+  - provider: pypi
+    name: django
+`,
+			wantOutput: "No differences. Tracked projects match the manifest.\n",
+		},
+		{
+			name: "differences",
+			manifest: `projects:
+  - provider: github
+    name: golang/go
+    email: daily
+    regex-exclude:
+      - value: ^0\.1
+    exclude-prereleases: true
+    tags: [33f1db7254b9]
+  - provider: npm
+    name: vue
+    email: weekly
+`,
+			args: []string{"--prune"},
+			wantOutput: "--- tracked projects\n+++ FILE\n" +
+				"@@ github golang/go @@\n-regex-exclude: [^0\\.1 (inverse)]\n+regex-exclude: [^0\\.1]\n-tags: [1d33b7254b9f, 33f1db7254b9]\n+tags: [33f1db7254b9]\n" +
+				"@@ npm vue (not tracked) @@\n+email: weekly\n+slack: []\n+telegram: []\n+discord: []\n+hangouts-chat: []\n+microsoft-teams: []\n+mattermost: []\n+rocketchat: []\n+matrix: []\n+webhook: []\n+regex-exclude: []\n+exclude-prereleases: no\n+exclude-updated: no\n+note: \"\"\n+tags: []\n" +
+				"@@ pypi django (not in manifest) @@\n-email: none\n-slack: []\n-telegram: []\n-discord: []\n-hangouts-chat: []\n-microsoft-teams: []\n-mattermost: []\n-rocketchat: []\n-matrix: []\n-webhook: []\n-regex-exclude: []\n-exclude-prereleases: no\n-exclude-updated: no\n-note: \"\"\n-tags: []\n",
+			wantExitCode: cmd.ExitCodeDrift,
+		},
+		{
+			name: "differences without prune",
+			manifest: `projects:
+  - provider: github
+    name: golang/go
+    email: weekly
+    regex-exclude:
+      - value: ^0\.1
+        inverse: true
+    exclude-prereleases: true
+    tags: [33f1db7254b9, 1d33b7254b9f]
+`,
+			wantOutput:   "--- tracked projects\n+++ FILE\n@@ github golang/go @@\n-email: daily\n+email: weekly\n",
+			wantExitCode: cmd.ExitCodeDrift,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "projects.yaml")
+			if err := os.WriteFile(file, []byte(tc.manifest), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			var outputBuf bytes.Buffer
+			err := newCommand(t,
+				cmd.WithArgs(append([]string{"diff", "-f", file}, tc.args...)...),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithProjectsService(newMockProjectsService(1, nil, projects)),
+			).Execute()
+			if tc.wantExitCode != 0 {
+				var e *cmd.ExitError
+				if !errors.As(err, &e) || e.Code != tc.wantExitCode {
+					t.Fatalf("got error %v, want exit code %v", err, tc.wantExitCode)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			wantOutput := strings.ReplaceAll(tc.wantOutput, "FILE", file)
+			if gotOutput := outputBuf.String(); gotOutput != wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+			}
+		})
+	}
+}
@@ -25,6 +25,10 @@ func (c *command) initDiscordCmd() (err error) {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, channel); ok || err != nil {
+				return err
+			}
+
 			if len(channel) == 0 {
 				cmd.Println("No Discord Channels found.")
 				return nil
@@ -0,0 +1,37 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import "strconv"
+
+// Exit codes, other than 0 for success and 1 for a general error, that are
+// returned by commands that report their result through the exit code.
+const (
+	exitCodeDrift                   = 2
+	exitCodeOutdated                = 2
+	exitCodeUpdateAvailable         = 2
+	exitCodeSecurityUpdateAvailable = 3
+	exitCodeTimeout                 = 124
+	exitCodeInterrupted             = 130
+)
+
+// ExitError is returned by Execute when the program should exit with a
+// specific exit code. If Err is nil, there is no error message to be printed.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	if e.Err == nil {
+		return "exit code " + strconv.Itoa(e.Code)
+	}
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
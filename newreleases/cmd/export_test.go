@@ -5,7 +5,16 @@
 
 package cmd
 
-import "io"
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
 
 type (
 	Command                       = command
@@ -32,6 +41,14 @@ var (
 	NewCommand = newCommand
 )
 
+const (
+	ExitCodeDrift                   = exitCodeDrift
+	ExitCodeOutdated                = exitCodeOutdated
+	ExitCodeUpdateAvailable         = exitCodeUpdateAvailable
+	ExitCodeSecurityUpdateAvailable = exitCodeSecurityUpdateAvailable
+	ExitCodeTimeout                 = exitCodeTimeout
+)
+
 func WithCfgFile(f string) func(c *Command) {
 	return func(c *Command) {
 		c.cfgFile = f
@@ -44,6 +61,12 @@ func WithHomeDir(dir string) func(c *Command) {
 	}
 }
 
+func WithCacheDir(dir string) func(c *Command) {
+	return func(c *Command) {
+		c.cacheDir = dir
+	}
+}
+
 func WithArgs(a ...string) func(c *Command) {
 	return func(c *Command) {
 		c.root.SetArgs(a)
@@ -163,3 +186,109 @@ func WithTagsService(s TagsService) func(c *Command) {
 		c.tagsService = s
 	}
 }
+
+type SourceAccount struct {
+	Projects               ProjectsService
+	Tags                   TagsService
+	SlackChannels          SlackChannelsService
+	TelegramChats          TelegramChatsService
+	DiscordChannels        DiscordChannelsService
+	HangoutsChatWebhooks   HangoutsChatWebhooksService
+	MicrosoftTeamsWebhooks MicrosoftTeamsWebhooksService
+	MattermostWebhooks     MattermostWebhooksService
+	RocketchatWebhooks     RocketchatWebhooksService
+	MatrixRooms            MatrixRoomsService
+	Webhooks               WebhooksService
+}
+
+func (a SourceAccount) account() *account {
+	return &account{
+		projectsService:               a.Projects,
+		tagsService:                   a.Tags,
+		slackChannelsService:          a.SlackChannels,
+		telegramChatsService:          a.TelegramChats,
+		discordChannelsService:        a.DiscordChannels,
+		hangoutsChatWebhooksService:   a.HangoutsChatWebhooks,
+		microsoftTeamsWebhooksService: a.MicrosoftTeamsWebhooks,
+		mattermostWebhooksService:     a.MattermostWebhooks,
+		rocketchatWebhooksService:     a.RocketchatWebhooks,
+		matrixRoomsService:            a.MatrixRooms,
+		webhooksService:               a.Webhooks,
+	}
+}
+
+func WithSourceAccount(a SourceAccount) func(c *Command) {
+	return func(c *Command) {
+		c.sourceAccount = a.account()
+	}
+}
+
+// WithAccounts sets accounts that are returned for auth keys, instead of
+// creating API clients.
+func WithAccounts(accounts map[string]SourceAccount) func(c *Command) {
+	return func(c *Command) {
+		c.newAccount = func(cmd *cobra.Command, config *viper.Viper, authKey string) (*account, error) {
+			a, ok := accounts[authKey]
+			if !ok {
+				return nil, fmt.Errorf("unknown auth key %q", authKey)
+			}
+			return a.account(), nil
+		}
+	}
+}
+
+// NPMSpecVersion returns the pinned version of an npm package specification.
+func NPMSpecVersion(spec string) string {
+	return npmSpecVersion(spec)
+}
+
+// CompareVersions compares two versions as semantic versions, returning false
+// if any of them can not be parsed.
+func CompareVersions(a, b string) (int, bool) {
+	va, ok := parseSemanticVersion(a)
+	if !ok {
+		return 0, false
+	}
+	vb, ok := parseSemanticVersion(b)
+	if !ok {
+		return 0, false
+	}
+	return va.compare(vb), true
+}
+
+// MatchVersionConstraint returns true if the version satisfies the version
+// constraint.
+func MatchVersionConstraint(constraint, version string) (bool, error) {
+	c, err := parseVersionConstraint(constraint)
+	if err != nil {
+		return false, err
+	}
+	v, ok := parseSemanticVersion(version)
+	if !ok {
+		return false, fmt.Errorf("invalid version %q", version)
+	}
+	return c.match(v), nil
+}
+
+// NewRetryTransport returns the HTTP transport that retries requests with the
+// backoff that starts at one millisecond.
+func NewRetryTransport(base http.RoundTripper, retries int, maxWait time.Duration, log io.Writer) http.RoundTripper {
+	return &retryTransport{
+		base:   base,
+		policy: &retryPolicy{retries: retries, minWait: time.Millisecond, maxWait: maxWait, log: log},
+	}
+}
+
+// NewRetryingProjectsService returns the projects service that retries adding
+// projects with the backoff of one millisecond.
+func NewRetryingProjectsService(s ProjectsService, retries int, log io.Writer) ProjectsService {
+	return newRetryingProjectsService(s, &retryPolicy{retries: retries, minWait: time.Millisecond, maxWait: time.Millisecond, log: log})
+}
+
+// MarkTransientFailure reports a transient request failure as the
+// retryTransport does.
+func MarkTransientFailure(ctx context.Context) {
+	if result, ok := ctx.Value(requestResultKey{}).(*requestResult); ok {
+		result.transient = true
+	}
+}
@@ -8,6 +8,8 @@ package cmd
 import (
 	"bufio"
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"strconv"
 	"strings"
@@ -20,29 +22,78 @@ import (
 const cmdNameGetAuthKey = "get-auth-key"
 
 func (c *command) initGetAuthKeyCmd() (err error) {
+	optionNamePasswordStdin := "password-stdin"
+	optionNameKeyName := "key-name"
+	optionNamePrint := "print"
+
 	getAuthKeyCmd := &cobra.Command{
 		Use:   cmdNameGetAuthKey,
 		Short: "Get API auth key and store it in the configuration",
 		Long:  configurationHelp,
+		Annotations: map[string]string{
+			annotationWritesConfig: "true",
+		},
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
-			cmd.Println("Sign in to NewReleases with your credentials")
-			cmd.Println("to get available API keys and store them in local configuration file.")
-
-			reader := bufio.NewReader(cmd.InOrStdin())
-
-			email, err := terminalPrompt(cmd, reader, "Email")
+			flags := cmd.Flags()
+			email, err := flags.GetString(optionNameEmail)
+			if err != nil {
+				return err
+			}
+			passwordStdin, err := flags.GetBool(optionNamePasswordStdin)
+			if err != nil {
+				return err
+			}
+			keyName, err := flags.GetString(optionNameKeyName)
 			if err != nil {
 				return err
 			}
-			password, err := terminalPromptPassword(cmd, c.passwordReader, "Password")
+			printKey, err := flags.GetBool(optionNamePrint)
 			if err != nil {
 				return err
 			}
 
+			// Keep the standard output only for the printed auth key, so that
+			// it can be captured by scripts.
+			stdout := cmd.OutOrStdout()
+			if printKey {
+				cmd.SetOut(cmd.ErrOrStderr())
+			}
+
+			if email == "" || !passwordStdin {
+				cmd.Println("Sign in to NewReleases with your credentials")
+				cmd.Println("to get available API keys and store them in local configuration file.")
+			}
+
+			reader := bufio.NewReader(cmd.InOrStdin())
+
+			if email == "" {
+				email, err = terminalPrompt(cmd, reader, "Email")
+				if err != nil {
+					return err
+				}
+			}
+
+			var password string
+			if passwordStdin {
+				password, err = reader.ReadString('\n')
+				if err != nil && err != io.EOF {
+					return err
+				}
+				password = strings.TrimRight(password, "\r\n")
+				if password == "" {
+					return errors.New("no password provided on the standard input")
+				}
+			} else {
+				password, err = terminalPromptPassword(cmd, c.passwordReader, "Password")
+				if err != nil {
+					return err
+				}
+			}
+
 			ctx, cancel := newClientContext(c.config)
 			defer cancel()
 
-			o, err := newClientOptions(cmd)
+			o, err := newClientOptions(cmd, c.config)
 			if err != nil {
 				return err
 			}
@@ -51,6 +102,14 @@ func (c *command) initGetAuthKeyCmd() (err error) {
 				return err
 			}
 
+			if keyName != "" {
+				key, err := findAuthKey(keys, keyName)
+				if err != nil {
+					return err
+				}
+				return c.useAuthKey(cmd, stdout, key, printKey)
+			}
+
 			count := len(keys)
 			if count == 0 {
 				cmd.PrintErr("No auth keys found.\n")
@@ -86,14 +145,7 @@ func (c *command) initGetAuthKeyCmd() (err error) {
 				}
 			}
 
-			key := keys[selection]
-			if err := c.writeConfig(cmd, key.Secret); err != nil {
-				return err
-			}
-			cmd.Printf("Using auth key: %s.\n", key.Name)
-
-			cmd.Printf("Configuration saved to: %s.\n", c.cfgFile)
-			return nil
+			return c.useAuthKey(cmd, stdout, keys[selection], printKey)
 		},
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			if err := addClientConfigOptions(cmd, c.config); err != nil {
@@ -103,10 +155,41 @@ func (c *command) initGetAuthKeyCmd() (err error) {
 		},
 	}
 
+	getAuthKeyCmd.Flags().String(optionNameEmail, "", "account email address, prompted for if not specified")
+	getAuthKeyCmd.Flags().Bool(optionNamePasswordStdin, false, "read the password from the standard input")
+	getAuthKeyCmd.Flags().String(optionNameKeyName, "", "select the auth key with the name")
+	getAuthKeyCmd.Flags().Bool(optionNamePrint, false, "print the auth key instead of storing it in the configuration")
+
 	c.root.AddCommand(getAuthKeyCmd)
 	return addClientFlags(getAuthKeyCmd)
 }
 
+// useAuthKey writes the secret of the selected auth key to the standard
+// output if printKey is true, or otherwise saves it.
+func (c *command) useAuthKey(cmd *cobra.Command, stdout io.Writer, key newreleases.AuthKey, printKey bool) (err error) {
+	if printKey {
+		_, err := fmt.Fprintln(stdout, key.Secret)
+		return err
+	}
+	cmd.Printf("Using auth key: %s.\n", key.Name)
+	return c.saveAuthKey(cmd, key.Secret)
+}
+
+// findAuthKey returns the auth key with the name.
+func findAuthKey(keys []newreleases.AuthKey, name string) (key newreleases.AuthKey, err error) {
+	names := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k.Name == name {
+			return k, nil
+		}
+		names = append(names, k.Name)
+	}
+	if len(names) == 0 {
+		return key, fmt.Errorf("auth key %q not found, the account has no auth keys", name)
+	}
+	return key, fmt.Errorf("auth key %q not found, available auth keys are: %s", name, strings.Join(names, ", "))
+}
+
 func (c *command) setAuthKeysGetter(cmd *cobra.Command, args []string) (err error) {
 	if c.authKeysGetter != nil {
 		return nil
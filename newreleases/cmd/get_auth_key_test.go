@@ -212,6 +212,113 @@ func TestGetAuthKeyCmd(t *testing.T) {
 	}
 }
 
+func TestGetAuthKeyCmd_nonInteractive(t *testing.T) {
+	keys := []newreleases.AuthKey{
+		{Name: "Master", Secret: "z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71"},
+		{Name: "Secondary", Secret: "ne0sg5a9b4qOpc9ty6az8jwn5n16rpymcw71"},
+	}
+
+	for _, tc := range []struct {
+		name            string
+		args            []string
+		input           string
+		authKeysGetter  cmd.AuthKeysGetter
+		wantOutputFunc  func(filename string) string
+		wantErrorOutput string
+		wantData        string
+		wantError       string
+	}{
+		{
+			name:           "key name",
+			args:           []string{"--email", "me@newreleases.io", "--password-stdin", "--key-name", "Secondary"},
+			input:          "myPassword\n",
+			authKeysGetter: newMockAuthKeysGetter("me@newreleases.io", "myPassword", keys, nil),
+			wantOutputFunc: func(filename string) string {
+				return fmt.Sprintf("Using auth key: Secondary.\nConfiguration saved to: %s.\n", filename)
+			},
+			wantData: "auth-key: ne0sg5a9b4qOpc9ty6az8jwn5n16rpymcw71\ntimeout: 30s\n",
+		},
+		{
+			name:           "print",
+			args:           []string{"--email", "me@newreleases.io", "--password-stdin", "--key-name", "Master", "--print"},
+			input:          "myPassword",
+			authKeysGetter: newMockAuthKeysGetter("me@newreleases.io", "myPassword", keys, nil),
+			wantOutputFunc: func(string) string {
+				return "z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n"
+			},
+		},
+		{
+			name:           "print with prompts",
+			args:           []string{"--print"},
+			input:          "me@newreleases.io\n2\n",
+			authKeysGetter: newMockAuthKeysGetter("me@newreleases.io", "myPassword", keys, nil),
+			wantOutputFunc: func(string) string {
+				return "ne0sg5a9b4qOpc9ty6az8jwn5n16rpymcw71\n"
+			},
+			wantErrorOutput: "Sign in to NewReleases with your credentials\nto get available API keys and store them in local configuration file.\nEmail: Password: \n\n    NAME        AUTHORIZED NETWORKS \n1   Master                            \n2   Secondary                         \n\nSelect auth key (enter row number): ",
+		},
+		{
+			name:           "key name not found",
+			args:           []string{"--email", "me@newreleases.io", "--password-stdin", "--key-name", "CI"},
+			input:          "myPassword\n",
+			authKeysGetter: newMockAuthKeysGetter("me@newreleases.io", "myPassword", keys, nil),
+			wantOutputFunc: func(string) string { return "" },
+			wantError:      `auth key "CI" not found, available auth keys are: Master, Secondary`,
+		},
+		{
+			name:           "key name without keys",
+			args:           []string{"--email", "me@newreleases.io", "--password-stdin", "--key-name", "CI"},
+			input:          "myPassword\n",
+			authKeysGetter: newMockAuthKeysGetter("me@newreleases.io", "myPassword", nil, nil),
+			wantOutputFunc: func(string) string { return "" },
+			wantError:      `auth key "CI" not found, the account has no auth keys`,
+		},
+		{
+			name:           "empty password",
+			args:           []string{"--email", "me@newreleases.io", "--password-stdin"},
+			input:          "\n",
+			authKeysGetter: newMockAuthKeysGetter("me@newreleases.io", "myPassword", keys, nil),
+			wantOutputFunc: func(string) string { return "" },
+			wantError:      "no password provided on the standard input",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			cfgFile := filepath.Join(dir, ".newreleases.yaml")
+
+			var outputBuf, errorOutputBuf bytes.Buffer
+			err := newCommand(t,
+				cmd.WithHomeDir(dir),
+				cmd.WithArgs(append([]string{"get-auth-key"}, tc.args...)...),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithErrorOutput(&errorOutputBuf),
+				cmd.WithInput(strings.NewReader(tc.input)),
+				cmd.WithPasswordReader(newMockPasswordReader("myPassword", nil)),
+				cmd.WithAuthKeysGetter(tc.authKeysGetter),
+			).Execute()
+			if tc.wantError != "" {
+				if err == nil || err.Error() != tc.wantError {
+					t.Fatalf("got error %v, want %v", err, tc.wantError)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if gotOutput, wantOutput := outputBuf.String(), tc.wantOutputFunc(cfgFile); gotOutput != wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+			}
+			if gotErrorOutput := errorOutputBuf.String(); gotErrorOutput != tc.wantErrorOutput {
+				t.Errorf("got error output %q, want %q", gotErrorOutput, tc.wantErrorOutput)
+			}
+
+			gotData, _ := os.ReadFile(cfgFile)
+			if string(gotData) != tc.wantData {
+				t.Errorf("got config file data %q, want %q", string(gotData), tc.wantData)
+			}
+		})
+	}
+}
+
 type mockPasswordReader struct {
 	password string
 	err      error
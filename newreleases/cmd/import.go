@@ -0,0 +1,205 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"newreleases.io/newreleases"
+)
+
+func (c *command) initImportCmd() (err error) {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Track dependencies of a software project",
+		Long: `Track dependencies of a software project.
+
+Dependencies are read from files of a package manager and added as tracked
+projects with options that are the same as for the project add command.
+Dependencies that are already tracked are not changed.`,
+	}
+
+	if err := c.initImportGoModCmd(cmd); err != nil {
+		return err
+	}
+	if err := c.initImportNPMCmd(cmd); err != nil {
+		return err
+	}
+	if err := c.initImportPyPICmd(cmd); err != nil {
+		return err
+	}
+	if err := c.initImportImagesCmd(cmd); err != nil {
+		return err
+	}
+	if err := c.initImportActionsCmd(cmd); err != nil {
+		return err
+	}
+
+	c.root.AddCommand(cmd)
+	return nil
+}
+
+// importProject is a dependency that should be tracked. If the dependency
+// can not be tracked, the reason is set instead of provider and name. The
+// version is the required version of the dependency, if it is pinned. Tag IDs
+// and exclusions are added to the ones from the project options flags.
+type importProject struct {
+	source     string
+	provider   string
+	name       string
+	version    string
+	reason     string
+	tagIDs     []string
+	exclusions []newreleases.Exclusion
+}
+
+func (p importProject) key() string {
+	return p.provider + "/" + p.name
+}
+
+const (
+	optionNameDryRun = "dry-run"
+
+	importStatusAdded   = "added"
+	importStatusToAdd   = "to be added"
+	importStatusTracked = "already tracked"
+)
+
+// newImportCmd returns a subcommand of the import command that tracks
+// dependencies returned by the read function.
+func (c *command) newImportCmd(use, short, long string, read func(cmd *cobra.Command, args []string) ([]importProject, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Long:  long,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			o, err := newProjectOptionsFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			dryRun, err := cmd.Flags().GetBool(optionNameDryRun)
+			if err != nil {
+				return err
+			}
+
+			projects, err := read(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			return c.importProjects(cmd, projects, o, dryRun)
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := addClientConfigOptions(cmd, c.config); err != nil {
+				return err
+			}
+			return c.setProjectsService(cmd, args)
+		},
+	}
+
+	addProjectOptionsFlags(cmd.Flags())
+	cmd.Flags().Bool(optionNameDryRun, false, "only list dependencies that would be added")
+
+	return cmd
+}
+
+// importProjects adds projects that are not already tracked with the same
+// options and prints the status of every project. If dryRun is true, projects
+// are not added.
+func (c *command) importProjects(cmd *cobra.Command, projects []importProject, o *newreleases.ProjectOptions, dryRun bool) (err error) {
+	// Dependencies may be found multiple times with different tags.
+	tagIDs := make(map[string][]string)
+	for _, p := range projects {
+		tagIDs[p.key()] = append(tagIDs[p.key()], p.tagIDs...)
+	}
+
+	var rows [][]string
+	var added, tracked, skipped int
+	seen := make(map[string]struct{})
+	for _, p := range projects {
+		if p.reason != "" {
+			rows = append(rows, []string{p.source, "", "", p.reason})
+			skipped++
+			continue
+		}
+		if _, ok := seen[p.key()]; ok {
+			continue
+		}
+		seen[p.key()] = struct{}{}
+
+		po := o
+		if ids := tagIDs[p.key()]; len(ids) > 0 || len(p.exclusions) > 0 {
+			po = withTagIDsAndExclusions(o, ids, p.exclusions)
+		}
+		status, err := c.importProject(p, po, dryRun)
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", p.provider, p.name, err)
+		}
+		switch status {
+		case importStatusAdded, importStatusToAdd:
+			added++
+		case importStatusTracked:
+			tracked++
+		}
+		rows = append(rows, []string{p.source, p.provider, p.name, status})
+	}
+
+	if len(rows) == 0 {
+		cmd.Println("No dependencies found.")
+		return nil
+	}
+
+	table := newTable(cmd.OutOrStdout())
+	table.SetHeader([]string{"Dependency", "Provider", "Name", "Status"})
+	table.AppendBulk(rows)
+	table.Render()
+
+	cmd.Println()
+	if dryRun {
+		cmd.Printf("Projects: %v to be added, %v already tracked, %v skipped.\n", added, tracked, skipped)
+		return nil
+	}
+	cmd.Printf("Projects: %v added, %v already tracked, %v skipped.\n", added, tracked, skipped)
+	return nil
+}
+
+func (c *command) importProject(p importProject, o *newreleases.ProjectOptions, dryRun bool) (status string, err error) {
+	ctx, cancel := newClientContext(c.config)
+	defer cancel()
+
+	project, err := c.projectsService.GetByName(ctx, p.provider, p.name)
+	if err != nil && err != newreleases.ErrNotFound {
+		return "", err
+	}
+	if project != nil && err == nil {
+		return importStatusTracked, nil
+	}
+	if dryRun {
+		return importStatusToAdd, nil
+	}
+	if _, err := c.projectsService.Add(ctx, p.provider, p.name, o); err != nil {
+		return "", err
+	}
+	return importStatusAdded, nil
+}
+
+// withTagIDsAndExclusions returns a copy of project options with additional
+// tag IDs and exclusions.
+func withTagIDsAndExclusions(o *newreleases.ProjectOptions, ids []string, exclusions []newreleases.Exclusion) *newreleases.ProjectOptions {
+	po := *o
+	po.Exclusions = append(append([]newreleases.Exclusion(nil), o.Exclusions...), exclusions...)
+	po.TagIDs = nil
+	seen := make(map[string]struct{})
+	for _, id := range append(append([]string(nil), o.TagIDs...), ids...) {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		po.TagIDs = append(po.TagIDs, id)
+	}
+	return &po
+}
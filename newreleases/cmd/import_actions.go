@@ -0,0 +1,170 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+	"newreleases.io/newreleases"
+)
+
+// defaultActionVersionRegex matches versions of actions that are released
+// with full semantic version tags, excluding floating major version tags.
+const defaultActionVersionRegex = `^v?[0-9]+\.[0-9]+\.[0-9]+$`
+
+func (c *command) initImportActionsCmd(importCmd *cobra.Command) (err error) {
+	optionNameVersionRegex := "version-regex"
+
+	cmd := c.newImportCmd(
+		"actions [PATH...]",
+		"Track GitHub Actions used in workflows",
+		`Track GitHub repositories of actions and reusable workflows that are used in
+GitHub Actions workflow files.
+
+Paths can be workflow files or directories, by default .github/workflows.
+References to actions in subdirectories of repositories, like
+owner/repo/path@v3, are tracked as the repository. Local actions and Docker
+images are ignored.
+
+Only versions that match the regular expression set with --version-regex
+flag are notified, by default full semantic versions, so that updates of
+floating tags, like v4, are excluded. Set it to an empty string to be
+notified about all versions.`,
+		func(cmd *cobra.Command, args []string) (projects []importProject, err error) {
+			versionRegex, err := cmd.Flags().GetString(optionNameVersionRegex)
+			if err != nil {
+				return nil, err
+			}
+			var exclusions []newreleases.Exclusion
+			if versionRegex != "" {
+				if _, err := regexp.Compile(versionRegex); err != nil {
+					return nil, fmt.Errorf("version regex: %w", err)
+				}
+				exclusions = []newreleases.Exclusion{{Value: versionRegex, Inverse: true}}
+			}
+
+			if len(args) == 0 {
+				args = []string{filepath.Join(".github", "workflows")}
+			}
+			for _, path := range args {
+				uses, err := readWorkflowsUses(path)
+				if err != nil {
+					return nil, err
+				}
+				for _, u := range uses {
+					if strings.HasPrefix(u, "./") || strings.HasPrefix(u, "docker://") {
+						continue
+					}
+					p := newActionImportProject(u)
+					p.exclusions = exclusions
+					projects = append(projects, p)
+				}
+			}
+			return projects, nil
+		},
+	)
+
+	cmd.Flags().String(optionNameVersionRegex, defaultActionVersionRegex, "notify only about versions that match the regular expression")
+
+	importCmd.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+// readWorkflowsUses returns values of uses keys of jobs and steps from a
+// workflow file or from all YAML files in a directory tree.
+func readWorkflowsUses(path string) (uses []string, err error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return readWorkflowUses(path)
+	}
+
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if ext := filepath.Ext(p); ext != ".yml" && ext != ".yaml" {
+			return nil
+		}
+		u, err := readWorkflowUses(p)
+		if err != nil {
+			return err
+		}
+		uses = append(uses, u...)
+		return nil
+	})
+	return uses, err
+}
+
+func readWorkflowUses(filename string) (uses []string, err error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return uses, nil
+			}
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		if len(doc.Content) == 0 {
+			continue
+		}
+		for _, job := range yamlMappingValues(yamlMappingValue(doc.Content[0], "jobs")) {
+			// Reusable workflows.
+			if u := yamlMappingValue(job, "uses"); u != nil && u.Kind == yaml.ScalarNode {
+				uses = append(uses, u.Value)
+			}
+			steps := yamlMappingValue(job, "steps")
+			if steps == nil || steps.Kind != yaml.SequenceNode {
+				continue
+			}
+			for _, step := range steps.Content {
+				if u := yamlMappingValue(step, "uses"); u != nil && u.Kind == yaml.ScalarNode {
+					uses = append(uses, u.Value)
+				}
+			}
+		}
+	}
+}
+
+// newActionImportProject returns a project for the repository of an action
+// or a reusable workflow reference, like owner/repo/path@v3.
+func newActionImportProject(uses string) importProject {
+	uses = strings.TrimSpace(uses)
+	p := importProject{source: uses}
+	if strings.Contains(uses, "${{") {
+		p.reason = "contains expressions"
+		return p
+	}
+	path, _, ok := strings.Cut(uses, "@")
+	elements := strings.Split(path, "/")
+	if !ok || len(elements) < 2 || elements[0] == "" || elements[1] == "" {
+		p.reason = importReasonUnparseable
+		return p
+	}
+	p.provider, p.name = "github", strings.ToLower(elements[0]+"/"+elements[1])
+	return p
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"reflect"
+	"testing"
+
+	"newreleases.io/newreleases"
+)
+
+func TestImportCmd_Actions(t *testing.T) {
+	files := map[string]string{
+		".github/workflows/build.yml": `name: Build
+on: [push]
+jobs:
+  build:
+    runs-on: ubuntu-latest
+    steps:
+      - uses: actions/checkout@v4
+      - uses: actions/setup-go@v5
+        with:
+          go-version: stable
+      - run: go test ./...
+      - uses: ./.github/actions/local
+      - uses: docker://alpine:3.20
+      - uses: github/codeql-action/init@v3
+      - uses: ${{ matrix.action }}
+  release:
+    uses: Org/Workflows/.github/workflows/release.yml@main
+`,
+		".github/workflows/lint.yaml": `jobs:
+  lint:
+    steps:
+      - uses: actions/checkout@v4
+      - uses: golangci/golangci-lint-action
+`,
+	}
+
+	for _, tc := range []struct {
+		name           string
+		args           []string
+		tracked        []string
+		wantOutput     string
+		wantAdded      []string
+		wantExclusions []newreleases.Exclusion
+	}{
+		{
+			name:    "default",
+			tracked: []string{"github actions/setup-go"},
+			wantOutput: "DEPENDENCY                                         PROVIDER   NAME                   STATUS               \n" +
+				"actions/checkout@v4                                github     actions/checkout       added                  \n" +
+				"actions/setup-go@v5                                github     actions/setup-go       already tracked        \n" +
+				"github/codeql-action/init@v3                       github     github/codeql-action   added                  \n" +
+				"${{ matrix.action }}                                                                 contains expressions   \n" +
+				"Org/Workflows/.github/workflows/release.yml@main   github     org/workflows          added                  \n" +
+				"golangci/golangci-lint-action                                                        unparseable            \n" +
+				"\n" +
+				"Projects: 3 added, 1 already tracked, 2 skipped.\n",
+			wantAdded:      []string{"github actions/checkout", "github github/codeql-action", "github org/workflows"},
+			wantExclusions: []newreleases.Exclusion{{Value: `^v?[0-9]+\.[0-9]+\.[0-9]+$`, Inverse: true}},
+		},
+		{
+			name:           "custom version regex",
+			args:           []string{"{dir}/.github/workflows/lint.yaml", "--version-regex", `^v[0-9]+\.[0-9]+$`},
+			wantAdded:      []string{"github actions/checkout"},
+			wantExclusions: []newreleases.Exclusion{{Value: `^v[0-9]+\.[0-9]+$`, Inverse: true}},
+		},
+		{
+			name:      "no version regex",
+			args:      []string{"{dir}/.github/workflows/lint.yaml", "--version-regex", ""},
+			wantAdded: []string{"github actions/checkout"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			args := tc.args
+			if len(args) == 0 {
+				args = []string{"{dir}/.github/workflows"}
+			}
+			gotOutput, s := runImportCmdWithService(t, files, tc.tracked, append([]string{"actions"}, args...)...)
+			if tc.wantOutput != "" && gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+			if !reflect.DeepEqual(s.added, tc.wantAdded) {
+				t.Errorf("got added %q, want %q", s.added, tc.wantAdded)
+			}
+			for _, p := range s.added {
+				if !reflect.DeepEqual(s.exclusions[p], tc.wantExclusions) {
+					t.Errorf("got %s exclusions %+v, want %+v", p, s.exclusions[p], tc.wantExclusions)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initImportGoModCmd(importCmd *cobra.Command) (err error) {
+	optionNameIndirect := "indirect"
+
+	cmd := c.newImportCmd(
+		"gomod [FILE]",
+		"Track Go module dependencies",
+		`Track Go modules that are required in a go.mod file, by default the one in
+the current directory.
+
+Modules are tracked as GitHub or GitLab repositories. Module paths of
+golang.org/x, gopkg.in and other well-known vanity import paths are mapped to
+their source repositories. Modules with other paths are skipped.`,
+		func(cmd *cobra.Command, args []string) (projects []importProject, err error) {
+			if len(args) > 1 {
+				return nil, cmd.Help()
+			}
+			filename := "go.mod"
+			if len(args) == 1 {
+				filename = args[0]
+			}
+			indirect, err := cmd.Flags().GetBool(optionNameIndirect)
+			if err != nil {
+				return nil, err
+			}
+
+			f, err := os.Open(filename)
+			if err != nil {
+				return nil, err
+			}
+			defer f.Close()
+
+			requirements, err := parseGoModRequirements(f)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filename, err)
+			}
+			for _, r := range requirements {
+				if r.indirect && !indirect {
+					continue
+				}
+				projects = append(projects, newGoModuleImportProject(r.path))
+			}
+			return projects, nil
+		},
+	)
+
+	cmd.Flags().Bool(optionNameIndirect, false, "also track indirect dependencies")
+
+	importCmd.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+type goModRequirement struct {
+	path     string
+	version  string
+	indirect bool
+}
+
+// parseGoModRequirements returns modules from require directives of a go.mod
+// file.
+func parseGoModRequirements(r io.Reader) (requirements []goModRequirement, err error) {
+	scanner := bufio.NewScanner(r)
+	var inBlock bool
+	var lineNumber int
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+
+		var comment string
+		if i := strings.Index(line, "//"); i >= 0 {
+			comment = strings.TrimSpace(line[i+2:])
+			line = strings.TrimSpace(line[:i])
+		}
+		if line == "" {
+			continue
+		}
+
+		if inBlock {
+			if line == ")" {
+				inBlock = false
+				continue
+			}
+		} else {
+			fields := strings.Fields(line)
+			if fields[0] != "require" {
+				// Skip other blocks, like replace and exclude, as a whole.
+				if len(fields) == 2 && fields[1] == "(" {
+					for scanner.Scan() {
+						lineNumber++
+						if strings.TrimSpace(scanner.Text()) == ")" {
+							break
+						}
+					}
+				}
+				continue
+			}
+			line = strings.TrimSpace(strings.TrimPrefix(line, "require"))
+			if line == "(" {
+				inBlock = true
+				continue
+			}
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %v: invalid require directive", lineNumber)
+		}
+		path := fields[0]
+		if strings.HasPrefix(path, `"`) || strings.HasPrefix(path, "`") {
+			path, err = strconv.Unquote(path)
+			if err != nil {
+				return nil, fmt.Errorf("line %v: invalid module path: %w", lineNumber, err)
+			}
+		}
+		requirements = append(requirements, goModRequirement{
+			path:     path,
+			version:  fields[1],
+			indirect: comment == "indirect" || strings.HasPrefix(comment, "indirect;"),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return requirements, nil
+}
+
+// goModuleRepositories maps vanity module paths to their source repositories
+// on GitHub.
+var goModuleRepositories = map[string]string{
+	"cloud.google.com/go":         "googleapis/google-cloud-go",
+	"go.etcd.io/bbolt":            "etcd-io/bbolt",
+	"go.mongodb.org/mongo-driver": "mongodb/mongo-go-driver",
+	"go.opentelemetry.io/otel":    "open-telemetry/opentelemetry-go",
+	"go.opentelemetry.io/contrib": "open-telemetry/opentelemetry-go-contrib",
+	"google.golang.org/api":       "googleapis/google-api-go-client",
+	"google.golang.org/appengine": "golang/appengine",
+	"google.golang.org/genproto":  "googleapis/go-genproto",
+	"google.golang.org/grpc":      "grpc/grpc-go",
+	"google.golang.org/protobuf":  "protocolbuffers/protobuf-go",
+	"gotest.tools":                "gotestyourself/gotest.tools",
+	"honnef.co/go/tools":          "dominikh/go-tools",
+	"mvdan.cc/gofumpt":            "mvdan/gofumpt",
+	"mvdan.cc/sh":                 "mvdan/sh",
+	"newreleases.io/newreleases":  "newreleasesio/go-newreleases",
+	"newreleases.io/cmd":          "newreleasesio/cli-go",
+}
+
+// goModuleOwners maps module path prefixes to GitHub organizations which
+// host repositories with names that are the same as the first path element
+// after the prefix.
+var goModuleOwners = map[string]string{
+	"golang.org/x/": "golang",
+	"go.uber.org/":  "uber-go",
+	"k8s.io/":       "kubernetes",
+	"sigs.k8s.io/":  "kubernetes-sigs",
+	"go.etcd.io/":   "etcd-io",
+}
+
+var (
+	goModuleMajorVersionRegex = regexp.MustCompile(`/v[0-9]+$`)
+	goModuleGopkgRegex        = regexp.MustCompile(`^gopkg\.in/(?:([^/.]+)/)?([^/.]+)\.v[0-9]+`)
+)
+
+// newGoModuleImportProject returns a project of the repository where the
+// module source is hosted.
+func newGoModuleImportProject(path string) importProject {
+	p := importProject{source: path}
+
+	modulePath := goModuleMajorVersionRegex.ReplaceAllString(path, "")
+
+	var longest string
+	for prefix := range goModuleRepositories {
+		if (modulePath == prefix || strings.HasPrefix(modulePath, prefix+"/")) && len(prefix) > len(longest) {
+			longest = prefix
+		}
+	}
+	if longest != "" {
+		p.provider, p.name = "github", goModuleRepositories[longest]
+		return p
+	}
+
+	for prefix, owner := range goModuleOwners {
+		if strings.HasPrefix(modulePath, prefix) {
+			repo, _, _ := strings.Cut(strings.TrimPrefix(modulePath, prefix), "/")
+			if repo == "" {
+				break
+			}
+			p.provider, p.name = "github", owner+"/"+repo
+			return p
+		}
+	}
+
+	if m := goModuleGopkgRegex.FindStringSubmatch(modulePath); m != nil {
+		owner := m[1]
+		if owner == "" {
+			owner = "go-" + m[2]
+		}
+		p.provider, p.name = "github", owner+"/"+m[2]
+		return p
+	}
+
+	elements := strings.Split(modulePath, "/")
+	switch elements[0] {
+	case "github.com":
+		if len(elements) >= 3 {
+			p.provider, p.name = "github", elements[1]+"/"+elements[2]
+			return p
+		}
+	case "gitlab.com":
+		if len(elements) >= 3 {
+			p.provider, p.name = "gitlab", elements[1]+"/"+elements[2]
+			return p
+		}
+	}
+
+	p.reason = "unknown source repository"
+	return p
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestImportCmd_GoMod(t *testing.T) {
+	goMod := `module example.com/app
+
+go 1.24.0
+
+require github.com/spf13/cobra v1.9.1
+
+require (
+	github.com/aws/aws-sdk-go-v2/service/s3 v1.58.0
+	github.com/go-chi/chi/v5 v5.1.0
+	golang.org/x/term v0.29.0
+	gopkg.in/yaml.v3 v3.0.1
+	google.golang.org/grpc v1.65.0
+	example.org/private v1.0.0
+	"gitlab.com/gitlab-org/api/client-go" v0.110.0
+)
+
+require (
+	github.com/spf13/pflag v1.0.6 // indirect
+	golang.org/x/sys v0.30.0 // indirect
+)
+
+replace (
+	github.com/go-chi/chi/v5 => ../chi
+)
+`
+
+	for _, tc := range []struct {
+		name       string
+		args       []string
+		tracked    []string
+		wantOutput string
+		wantAdded  []string
+	}{
+		{
+			name:    "direct",
+			tracked: []string{"github spf13/cobra"},
+			wantOutput: "DEPENDENCY                                PROVIDER   NAME                STATUS                    \n" +
+				"github.com/spf13/cobra                    github     spf13/cobra         already tracked             \n" +
+				"github.com/aws/aws-sdk-go-v2/service/s3   github     aws/aws-sdk-go-v2   added                       \n" +
+				"github.com/go-chi/chi/v5                  github     go-chi/chi          added                       \n" +
+				"golang.org/x/term                         github     golang/term         added                       \n" +
+				"gopkg.in/yaml.v3                          github     go-yaml/yaml        added                       \n" +
+				"google.golang.org/grpc                    github     grpc/grpc-go        added                       \n" +
+				"example.org/private                                                      unknown source repository   \n" +
+				"gitlab.com/gitlab-org/api/client-go       gitlab     gitlab-org/api      added                       \n" +
+				"\n" +
+				"Projects: 6 added, 1 already tracked, 1 skipped.\n",
+			wantAdded: []string{"github aws/aws-sdk-go-v2", "github go-chi/chi", "github golang/term", "github go-yaml/yaml", "github grpc/grpc-go", "gitlab gitlab-org/api"},
+		},
+		{
+			name:      "indirect",
+			args:      []string{"--indirect"},
+			tracked:   []string{"github spf13/cobra", "github aws/aws-sdk-go-v2", "github go-chi/chi", "github golang/term", "github go-yaml/yaml", "github grpc/grpc-go", "gitlab gitlab-org/api"},
+			wantAdded: []string{"github spf13/pflag", "github golang/sys"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			gotOutput, gotAdded := runImportCmd(t, map[string]string{"go.mod": goMod}, tc.tracked, append([]string{"gomod", "{dir}/go.mod"}, tc.args...)...)
+			if tc.wantOutput != "" && gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+			if !reflect.DeepEqual(gotAdded, tc.wantAdded) {
+				t.Errorf("got added %q, want %q", gotAdded, tc.wantAdded)
+			}
+		})
+	}
+}
@@ -0,0 +1,348 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Kinds of files that reference container images.
+const (
+	imageSourceDockerfile = "dockerfile"
+	imageSourceCompose    = "compose"
+	imageSourceKubernetes = "kubernetes"
+)
+
+func (c *command) initImportImagesCmd(importCmd *cobra.Command) (err error) {
+	var (
+		optionNameDockerfileTag = "dockerfile-tag"
+		optionNameComposeTag    = "compose-tag"
+		optionNameKubernetesTag = "kubernetes-tag"
+	)
+
+	cmd := c.newImportCmd(
+		"images [PATH...]",
+		"Track container images",
+		`Track repositories of container images that are referenced in Dockerfiles,
+Docker Compose files and Kubernetes manifests.
+
+Paths can be files or directories, by default the current directory.
+Directories are searched recursively for Dockerfiles, Containerfiles, Compose
+files and YAML files with Kubernetes resources, including ones rendered from
+Helm charts.
+
+Images from Docker Hub, GitHub Container Registry and Quay are tracked as
+repositories without tags and digests. Images from other registries and the
+ones that are referenced with variables are skipped.
+
+In addition to the --tag flag, tags can be set only for images from one kind
+of files with the --dockerfile-tag, --compose-tag and --kubernetes-tag flags.`,
+		func(cmd *cobra.Command, args []string) (projects []importProject, err error) {
+			flags := cmd.Flags()
+			tagIDs := make(map[string][]string)
+			for kind, name := range map[string]string{
+				imageSourceDockerfile: optionNameDockerfileTag,
+				imageSourceCompose:    optionNameComposeTag,
+				imageSourceKubernetes: optionNameKubernetesTag,
+			} {
+				if tagIDs[kind], err = flags.GetStringArray(name); err != nil {
+					return nil, err
+				}
+			}
+
+			if len(args) == 0 {
+				args = []string{"."}
+			}
+			for _, path := range args {
+				p, err := readImages(path)
+				if err != nil {
+					return nil, err
+				}
+				for _, i := range p {
+					i.project.tagIDs = tagIDs[i.kind]
+					projects = append(projects, i.project)
+				}
+			}
+			return projects, nil
+		},
+	)
+
+	cmd.Flags().StringArray(optionNameDockerfileTag, nil, "Tag ID for images from Dockerfiles")
+	cmd.Flags().StringArray(optionNameComposeTag, nil, "Tag ID for images from Compose files")
+	cmd.Flags().StringArray(optionNameKubernetesTag, nil, "Tag ID for images from Kubernetes manifests")
+
+	importCmd.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+type imageReference struct {
+	kind    string
+	project importProject
+}
+
+// readImages returns images from a file or from all recognized files in a
+// directory tree.
+func readImages(path string) (images []imageReference, err error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		kind := imageSourceKind(path)
+		if kind == "" {
+			kind = imageSourceKubernetes
+		}
+		return readImagesFile(path, kind, false)
+	}
+
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != path && (strings.HasPrefix(d.Name(), ".") || d.Name() == "node_modules" || d.Name() == "vendor") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		kind := imageSourceKind(p)
+		if kind == "" {
+			return nil
+		}
+		i, err := readImagesFile(p, kind, true)
+		if err != nil {
+			return err
+		}
+		images = append(images, i...)
+		return nil
+	})
+	return images, err
+}
+
+// imageSourceKind returns the kind of the file by its name or an empty string
+// if the file is not recognized.
+func imageSourceKind(path string) string {
+	name := filepath.Base(path)
+	ext := strings.ToLower(filepath.Ext(name))
+	switch {
+	case name == "Dockerfile" || name == "Containerfile",
+		strings.HasPrefix(name, "Dockerfile."), strings.HasSuffix(name, ".Dockerfile"),
+		strings.HasPrefix(name, "Containerfile."):
+		return imageSourceDockerfile
+	case ext == ".yml" || ext == ".yaml":
+		base := strings.TrimSuffix(name, filepath.Ext(name))
+		if strings.HasPrefix(base, "docker-compose") || strings.HasPrefix(base, "compose") {
+			return imageSourceCompose
+		}
+		return imageSourceKubernetes
+	}
+	return ""
+}
+
+// readImagesFile returns images from a file of the specified kind. If
+// onlyResources is true, YAML documents that are not Kubernetes resources are
+// ignored.
+func readImagesFile(filename, kind string, onlyResources bool) (images []imageReference, err error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []string
+	switch kind {
+	case imageSourceDockerfile:
+		refs = readDockerfileImages(data)
+	default:
+		refs, err = readYAMLImages(data, kind, onlyResources)
+	}
+	if err != nil {
+		if onlyResources {
+			// Files that are found in directories may be templates or other
+			// files that are not valid YAML.
+			return []imageReference{{kind: kind, project: importProject{source: filename, reason: importReasonUnparseable}}}, nil
+		}
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+
+	for _, ref := range refs {
+		images = append(images, imageReference{
+			kind:    kind,
+			project: newImageImportProject(ref),
+		})
+	}
+	return images, nil
+}
+
+// readDockerfileImages returns images from FROM instructions, excluding
+// references to previous build stages.
+func readDockerfileImages(data []byte) (refs []string) {
+	stages := make(map[string]struct{})
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 || !strings.EqualFold(fields[0], "FROM") {
+			continue
+		}
+		fields = fields[1:]
+		for len(fields) > 0 && strings.HasPrefix(fields[0], "--") {
+			fields = fields[1:]
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		image := fields[0]
+		if len(fields) >= 3 && strings.EqualFold(fields[1], "AS") {
+			stages[strings.ToLower(fields[2])] = struct{}{}
+		}
+		if _, ok := stages[strings.ToLower(image)]; ok || image == "scratch" {
+			continue
+		}
+		refs = append(refs, image)
+	}
+	return refs
+}
+
+// readYAMLImages returns images from all documents of a Compose file or a
+// file with Kubernetes resources. If onlyResources is true, only documents
+// with apiVersion and kind keys are read from Kubernetes files.
+func readYAMLImages(data []byte, kind string, onlyResources bool) (refs []string, err error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				return refs, nil
+			}
+			return nil, err
+		}
+		if len(doc.Content) == 0 {
+			continue
+		}
+		root := doc.Content[0]
+		if kind == imageSourceCompose {
+			for _, service := range yamlMappingValues(yamlMappingValue(root, "services")) {
+				if image := yamlMappingValue(service, "image"); image != nil && image.Kind == yaml.ScalarNode {
+					refs = append(refs, image.Value)
+				}
+			}
+			continue
+		}
+		if onlyResources && (yamlMappingValue(root, "apiVersion") == nil || yamlMappingValue(root, "kind") == nil) {
+			continue
+		}
+		refs = append(refs, containerImages(root, "")...)
+	}
+}
+
+// kubernetesContainerKeys are keys of lists with containers in Kubernetes pod
+// specifications.
+var kubernetesContainerKeys = map[string]struct{}{
+	"containers":          {},
+	"initContainers":      {},
+	"ephemeralContainers": {},
+}
+
+// containerImages returns images of all containers in a Kubernetes resource
+// where key is the key of the mapping which value is the node n.
+func containerImages(n *yaml.Node, key string) (refs []string) {
+	switch n.Kind {
+	case yaml.MappingNode:
+		_, isContainer := kubernetesContainerKeys[key]
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			if isContainer && k.Value == "image" && v.Kind == yaml.ScalarNode {
+				refs = append(refs, v.Value)
+				continue
+			}
+			refs = append(refs, containerImages(v, k.Value)...)
+		}
+	case yaml.SequenceNode:
+		for _, c := range n.Content {
+			refs = append(refs, containerImages(c, key)...)
+		}
+	}
+	return refs
+}
+
+// yamlMappingValue returns the value of the key if the node is a mapping.
+func yamlMappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// yamlMappingValues returns all values in the order of keys if the node is a
+// mapping.
+func yamlMappingValues(n *yaml.Node) (values []*yaml.Node) {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 1; i < len(n.Content); i += 2 {
+		values = append(values, n.Content[i])
+	}
+	return values
+}
+
+// imageRegistryProviders maps container registries to providers.
+var imageRegistryProviders = map[string]string{
+	"docker.io":            "dockerhub",
+	"index.docker.io":      "dockerhub",
+	"registry-1.docker.io": "dockerhub",
+	"ghcr.io":              "ghcr",
+	"quay.io":              "quay",
+}
+
+// newImageImportProject returns a project for the repository of an image
+// reference, like postgres:15.4 or ghcr.io/org/app:1.2@sha256:digest.
+func newImageImportProject(ref string) importProject {
+	p := importProject{source: ref}
+	if strings.Contains(ref, "$") || strings.Contains(ref, "{{") {
+		p.reason = "contains variables"
+		return p
+	}
+
+	name, _, _ := strings.Cut(ref, "@")
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		if tag := name[i+1:]; tag != "latest" {
+			p.version = tag
+		}
+		name = name[:i]
+	}
+	name = strings.ToLower(name)
+
+	registry := "docker.io"
+	if first, rest, ok := strings.Cut(name, "/"); ok && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		registry, name = first, rest
+	}
+	provider, ok := imageRegistryProviders[registry]
+	if !ok {
+		p.reason = "unsupported registry " + registry
+		return p
+	}
+	if provider == "dockerhub" && !strings.Contains(name, "/") {
+		// Official images.
+		name = "library/" + name
+	}
+	p.provider, p.name = provider, name
+	return p
+}
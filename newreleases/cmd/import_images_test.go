@@ -0,0 +1,108 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestImportCmd_Images(t *testing.T) {
+	files := map[string]string{
+		"Dockerfile": `ARG GO_VERSION=1.24
+FROM golang:${GO_VERSION} AS build
+FROM --platform=$BUILDPLATFORM docker.io/library/alpine:3.20@sha256:beefdbd8a1da6d2915566fde36db9db0b524eb737fc57cd1367effd16dc0d06d AS base
+FROM base
+FROM scratch
+COPY --from=build /app /app
+`,
+		"docker-compose.yml": `services:
+  db:
+    image: postgres:15.4
+  app:
+    image: ghcr.io/Org/App:1.2
+    build: .
+  cache:
+    image: quay.io/coreos/etcd:v3.5.0
+`,
+		"k8s/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+        - name: migrate
+          image: ghcr.io/org/app:1.2
+      containers:
+        - name: app
+          image: registry.k8s.io/pause:3.9
+        - name: proxy
+          image: envoyproxy/envoy:v1.30.1
+---
+apiVersion: v1
+kind: ConfigMap
+data:
+  image: not-a-container
+`,
+		"config.yaml": `image: ignored/because-not-a-resource:1
+`,
+		"chart/templates/deployment.yaml": `image: {{ .Values.image }}:{{ .Values.tag }}
+`,
+		".github/workflows/build.yml": `apiVersion: v1
+kind: Pod
+image: ignored/hidden-directory:1
+`,
+	}
+
+	t.Run("directory", func(t *testing.T) {
+		gotOutput, s := runImportCmdWithService(t, files, []string{"dockerhub library/postgres"}, "images", "{dir}", "--tag", "t1", "--compose-tag", "t2", "--kubernetes-tag", "t3")
+
+		wantAdded := []string{"dockerhub library/alpine", "ghcr org/app", "quay coreos/etcd", "dockerhub envoyproxy/envoy"}
+		if !reflect.DeepEqual(s.added, wantAdded) {
+			t.Errorf("got added %q, want %q", s.added, wantAdded)
+		}
+		wantTags := map[string][]string{
+			"dockerhub library/alpine":   {"t1"},
+			"ghcr org/app":               {"t1", "t2", "t3"},
+			"quay coreos/etcd":           {"t1", "t2"},
+			"dockerhub envoyproxy/envoy": {"t1", "t3"},
+		}
+		if !reflect.DeepEqual(s.tags, wantTags) {
+			t.Errorf("got tags %q, want %q", s.tags, wantTags)
+		}
+		for _, want := range []string{
+			"golang:${GO_VERSION}",
+			"contains variables",
+			"registry.k8s.io/pause:3.9",
+			"unsupported registry registry.k8s.io",
+			"deployment.yaml",
+			"unparseable",
+			"Projects: 4 added, 1 already tracked, 3 skipped.",
+		} {
+			if !strings.Contains(gotOutput, want) {
+				t.Errorf("output %q does not contain %q", gotOutput, want)
+			}
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		gotOutput, gotAdded := runImportCmd(t, files, nil, "images", "{dir}/docker-compose.yml", "--dry-run")
+
+		wantOutput := "DEPENDENCY                   PROVIDER    NAME               STATUS      \n" +
+			"postgres:15.4                dockerhub   library/postgres   to be added   \n" +
+			"ghcr.io/Org/App:1.2          ghcr        org/app            to be added   \n" +
+			"quay.io/coreos/etcd:v3.5.0   quay        coreos/etcd        to be added   \n" +
+			"\n" +
+			"Projects: 3 to be added, 0 already tracked, 0 skipped.\n"
+		if gotOutput != wantOutput {
+			t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+		}
+		if gotAdded != nil {
+			t.Errorf("got added %q, want none", gotAdded)
+		}
+	})
+}
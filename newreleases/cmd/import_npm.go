@@ -0,0 +1,257 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+func (c *command) initImportNPMCmd(importCmd *cobra.Command) (err error) {
+	optionNameDev := "dev"
+
+	cmd := c.newImportCmd(
+		"npm [FILE]",
+		"Track npm package dependencies",
+		`Track npm packages that are dependencies in a package.json, package-lock.json
+or yarn.lock file, by default package.json in the current directory.
+
+Packages from package.json are the ones listed in dependencies and, with the
+--dev flag, in devDependencies. Lockfiles list all installed packages,
+including transitive dependencies. Development packages are recognized in
+package-lock.json, while all packages from yarn.lock are tracked.
+
+Dependencies that are not installed from the npm registry, like local paths,
+git repositories and URLs, are skipped.`,
+		func(cmd *cobra.Command, args []string) (projects []importProject, err error) {
+			if len(args) > 1 {
+				return nil, cmd.Help()
+			}
+			filename := "package.json"
+			if len(args) == 1 {
+				filename = args[0]
+			}
+			dev, err := cmd.Flags().GetBool(optionNameDev)
+			if err != nil {
+				return nil, err
+			}
+
+			f, err := os.Open(filename)
+			if err != nil {
+				return nil, err
+			}
+			defer f.Close()
+
+			switch filepath.Base(filename) {
+			case "package-lock.json", "npm-shrinkwrap.json":
+				projects, err = readNPMPackageLock(f, dev)
+			case "yarn.lock":
+				projects, err = readYarnLock(f)
+			default:
+				projects, err = readNPMPackageJSON(f, dev)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filename, err)
+			}
+			return projects, nil
+		},
+	)
+
+	cmd.Flags().Bool(optionNameDev, false, "also track development dependencies")
+
+	importCmd.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+// readNPMPackageJSON returns packages from dependencies of a package.json
+// file in the order of their names.
+func readNPMPackageJSON(r io.Reader, dev bool) (projects []importProject, err error) {
+	var manifest struct {
+		Dependencies    map[string]string `json:"dependencies"`
+		DevDependencies map[string]string `json:"devDependencies"`
+	}
+	if err := json.NewDecoder(r).Decode(&manifest); err != nil {
+		return nil, err
+	}
+
+	projects = append(projects, newNPMImportProjects(manifest.Dependencies)...)
+	if dev {
+		projects = append(projects, newNPMImportProjects(manifest.DevDependencies)...)
+	}
+	return projects, nil
+}
+
+func newNPMImportProjects(dependencies map[string]string) (projects []importProject) {
+	names := make([]string, 0, len(dependencies))
+	for name := range dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		projects = append(projects, newNPMImportProject(name, dependencies[name]))
+	}
+	return projects
+}
+
+// newNPMImportProject returns a project for a package name and a version
+// specification from package.json, resolving package aliases.
+func newNPMImportProject(name, spec string) importProject {
+	p := importProject{source: name}
+	if alias, ok := strings.CutPrefix(spec, "npm:"); ok {
+		name = npmPackageName(alias)
+		spec = strings.TrimPrefix(alias, name+"@")
+	}
+	for _, prefix := range []string{"file:", "link:", "workspace:", "portal:", "git:", "git+", "github:", "gitlab:", "bitbucket:", "http:", "https:"} {
+		if strings.HasPrefix(spec, prefix) {
+			p.reason = "not from npm registry"
+			return p
+		}
+	}
+	// Shortcut for GitHub repositories, like "owner/repo#v1".
+	if strings.Contains(spec, "/") {
+		p.reason = "not from npm registry"
+		return p
+	}
+	p.provider, p.name, p.version = "npm", name, npmSpecVersion(spec)
+	return p
+}
+
+// npmSpecVersion returns the version from a specification that references a
+// single version exactly, like 1.2.3 or =1.2.3, or an empty string for ranges,
+// like ^1.2.3, ~1.2.3, >=1.2.3 or 1.2.x, and for tags.
+func npmSpecVersion(spec string) string {
+	version := strings.TrimSpace(spec)
+	version = strings.TrimPrefix(version, "=")
+	version = strings.TrimPrefix(version, "v")
+	if version == "" || version[0] < '0' || version[0] > '9' {
+		return ""
+	}
+	if v, ok := parseSemanticVersion(version); !ok || len(v.numbers) != 3 {
+		return ""
+	}
+	return version
+}
+
+// npmPackageName returns a package name from a specification in the
+// name@version format, where the name may be scoped, like @scope/name.
+func npmPackageName(spec string) string {
+	if i := strings.Index(spec[min(1, len(spec)):], "@"); i >= 0 {
+		return spec[:i+1]
+	}
+	return spec
+}
+
+// readNPMPackageLock returns packages from a package-lock.json file in the
+// order of their names. Both the packages section of lockfile versions 2 and 3
+// and the dependencies section of version 1 are supported.
+func readNPMPackageLock(r io.Reader, dev bool) (projects []importProject, err error) {
+	type dependency struct {
+		Name         string                     `json:"name"`
+		Version      string                     `json:"version"`
+		Resolved     string                     `json:"resolved"`
+		Dev          bool                       `json:"dev"`
+		Link         bool                       `json:"link"`
+		Dependencies map[string]json.RawMessage `json:"dependencies"`
+	}
+	var lock struct {
+		Packages     map[string]dependency      `json:"packages"`
+		Dependencies map[string]json.RawMessage `json:"dependencies"`
+	}
+	if err := json.NewDecoder(r).Decode(&lock); err != nil {
+		return nil, err
+	}
+
+	packages := make(map[string]string)
+	add := func(name string, d dependency) {
+		if d.Link || (d.Dev && !dev) {
+			return
+		}
+		if d.Name != "" {
+			name = d.Name
+		}
+		spec := d.Version
+		if strings.HasPrefix(d.Resolved, "git") || strings.HasPrefix(d.Resolved, "file:") {
+			spec = d.Resolved
+		}
+		if _, ok := packages[name]; !ok {
+			packages[name] = spec
+		}
+	}
+
+	if len(lock.Packages) > 0 {
+		for path, d := range lock.Packages {
+			i := strings.LastIndex(path, "node_modules/")
+			if i < 0 {
+				// The root project and workspaces.
+				continue
+			}
+			add(path[i+len("node_modules/"):], d)
+		}
+	} else {
+		var walk func(dependencies map[string]json.RawMessage) error
+		walk = func(dependencies map[string]json.RawMessage) error {
+			for name, data := range dependencies {
+				var d dependency
+				if err := json.Unmarshal(data, &d); err != nil {
+					return fmt.Errorf("dependency %s: %w", name, err)
+				}
+				add(name, d)
+				if err := walk(d.Dependencies); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if err := walk(lock.Dependencies); err != nil {
+			return nil, err
+		}
+	}
+
+	return newNPMImportProjects(packages), nil
+}
+
+// readYarnLock returns packages from a yarn.lock file, of both classic and
+// berry versions, in the order of their names.
+func readYarnLock(r io.Reader) (projects []importProject, err error) {
+	packages := make(map[string]string)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		// Entries start with unindented lines that list package
+		// specifications, like: "@babel/core@^7.0.0", "@babel/core@^7.1.0":
+		if line == "" || line[0] == ' ' || line[0] == '#' || !strings.HasSuffix(line, ":") {
+			continue
+		}
+		spec, _, _ := strings.Cut(strings.TrimSuffix(line, ":"), ",")
+		spec = strings.Trim(strings.TrimSpace(spec), `"`)
+		if spec == "__metadata" {
+			continue
+		}
+		name := npmPackageName(spec)
+		version := strings.TrimPrefix(spec, name+"@")
+		if strings.HasPrefix(version, "patch:") {
+			// Patched packages are also listed without the patch.
+			continue
+		}
+		if _, ok := packages[name]; ok {
+			continue
+		}
+		packages[name] = strings.TrimPrefix(version, "npm:")
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return newNPMImportProjects(packages), nil
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"reflect"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+)
+
+func TestImportCmd_NPM(t *testing.T) {
+	files := map[string]string{
+		"package.json": `{
+  "name": "app",
+  "dependencies": {
+    "vue": "^3.4.0",
+    "@vue/router": "~4.3.0",
+    "lodash-es": "npm:lodash@^4.17.21",
+    "local": "file:../local",
+    "fork": "github:owner/fork#v1"
+  },
+  "devDependencies": {
+    "vite": "^5.0.0",
+    "vue": "^3.4.0"
+  }
+}`,
+		"v3/package-lock.json": `{
+  "name": "app",
+  "lockfileVersion": 3,
+  "packages": {
+    "": {"name": "app", "dependencies": {"vue": "^3.4.0"}},
+    "node_modules/vue": {"version": "3.4.21"},
+    "node_modules/@vue/shared": {"version": "3.4.21"},
+    "node_modules/lodash-es": {"name": "lodash", "version": "4.17.21"},
+    "node_modules/vite": {"version": "5.2.0", "dev": true},
+    "node_modules/vite/node_modules/esbuild": {"version": "0.20.0", "dev": true},
+    "node_modules/ws": {"resolved": "packages/ws", "link": true}
+  }
+}`,
+		"v1/package-lock.json": `{
+  "name": "app",
+  "lockfileVersion": 1,
+  "dependencies": {
+    "vue": {"version": "3.4.21", "dependencies": {"@vue/shared": {"version": "3.4.21"}}},
+    "vite": {"version": "5.2.0", "dev": true}
+  }
+}`,
+		"classic/yarn.lock": `# THIS IS AN AUTOGENERATED FILE. DO NOT EDIT THIS FILE DIRECTLY.
+# yarn lockfile v1
+
+
+"@vue/shared@3.4.21", "@vue/shared@^3.4.0":
+  version "3.4.21"
+
+vue@^3.4.0:
+  version "3.4.21"
+  dependencies:
+    "@vue/shared" "3.4.21"
+`,
+		"berry/yarn.lock": `__metadata:
+  version: 8
+
+"app@workspace:.":
+  version: 0.0.0-use.local
+
+"resolve@npm:^1.22.0":
+  version: 1.22.8
+
+"resolve@patch:resolve@npm%3A^1.22.0#~builtin<compat/resolve>":
+  version: 1.22.8
+
+"vue@npm:^3.4.0, vue@npm:^3.4.21":
+  version: 3.4.21
+`,
+	}
+
+	for _, tc := range []struct {
+		name       string
+		args       []string
+		tracked    []string
+		wantOutput string
+		wantAdded  []string
+	}{
+		{
+			name:    "package.json",
+			args:    []string{"{dir}/package.json"},
+			tracked: []string{"npm vue"},
+			wantOutput: "DEPENDENCY    PROVIDER   NAME          STATUS                \n" +
+				"@vue/router   npm        @vue/router   added                   \n" +
+				"fork                                   not from npm registry   \n" +
+				"local                                  not from npm registry   \n" +
+				"lodash-es     npm        lodash        added                   \n" +
+				"vue           npm        vue           already tracked         \n" +
+				"\n" +
+				"Projects: 2 added, 1 already tracked, 2 skipped.\n",
+			wantAdded: []string{"npm @vue/router", "npm lodash"},
+		},
+		{
+			name:      "package.json with dev",
+			args:      []string{"{dir}/package.json", "--dev"},
+			wantAdded: []string{"npm @vue/router", "npm lodash", "npm vue", "npm vite"},
+		},
+		{
+			name:    "dry run",
+			args:    []string{"{dir}/package.json", "--dev", "--dry-run"},
+			tracked: []string{"npm vue", "npm lodash"},
+			wantOutput: "DEPENDENCY    PROVIDER   NAME          STATUS                \n" +
+				"@vue/router   npm        @vue/router   to be added             \n" +
+				"fork                                   not from npm registry   \n" +
+				"local                                  not from npm registry   \n" +
+				"lodash-es     npm        lodash        already tracked         \n" +
+				"vue           npm        vue           already tracked         \n" +
+				"vite          npm        vite          to be added             \n" +
+				"\n" +
+				"Projects: 2 to be added, 2 already tracked, 2 skipped.\n",
+		},
+		{
+			name:      "package-lock.json",
+			args:      []string{"{dir}/v3/package-lock.json"},
+			wantAdded: []string{"npm @vue/shared", "npm lodash", "npm vue"},
+		},
+		{
+			name:      "package-lock.json with dev",
+			args:      []string{"{dir}/v3/package-lock.json", "--dev"},
+			wantAdded: []string{"npm @vue/shared", "npm esbuild", "npm lodash", "npm vite", "npm vue"},
+		},
+		{
+			name:      "package-lock.json version 1",
+			args:      []string{"{dir}/v1/package-lock.json"},
+			wantAdded: []string{"npm @vue/shared", "npm vue"},
+		},
+		{
+			name:      "yarn.lock classic",
+			args:      []string{"{dir}/classic/yarn.lock"},
+			wantAdded: []string{"npm @vue/shared", "npm vue"},
+		},
+		{
+			name:      "yarn.lock berry",
+			args:      []string{"{dir}/berry/yarn.lock"},
+			wantAdded: []string{"npm resolve", "npm vue"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			gotOutput, gotAdded := runImportCmd(t, files, tc.tracked, append([]string{"npm"}, tc.args...)...)
+			if tc.wantOutput != "" && gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+			if !reflect.DeepEqual(gotAdded, tc.wantAdded) {
+				t.Errorf("got added %q, want %q", gotAdded, tc.wantAdded)
+			}
+		})
+	}
+}
+
+func TestNPMSpecVersion(t *testing.T) {
+	for _, tc := range []struct {
+		spec string
+		want string
+	}{
+		{spec: "1.2.3", want: "1.2.3"},
+		{spec: "=1.2.3", want: "1.2.3"},
+		{spec: "v1.2.3", want: "1.2.3"},
+		{spec: "1.2.3-rc.1", want: "1.2.3-rc.1"},
+		{spec: "^1.2.3"},
+		{spec: "~1.2.3"},
+		{spec: ">=1.2.3"},
+		{spec: "<2.0.0"},
+		{spec: ">=1.2.3 <2.0.0"},
+		{spec: "1.2.3 - 2.0.0"},
+		{spec: "1.2.3 || 2.0.0"},
+		{spec: "1.2"},
+		{spec: "1.2.x"},
+		{spec: "1.x"},
+		{spec: "*"},
+		{spec: ""},
+		{spec: "latest"},
+		{spec: "beta1"},
+		{spec: "https://example.com/package.tgz"},
+	} {
+		t.Run(tc.spec, func(t *testing.T) {
+			if got := cmd.NPMSpecVersion(tc.spec); got != tc.want {
+				t.Errorf("got version %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
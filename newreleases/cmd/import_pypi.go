@@ -0,0 +1,356 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/spf13/cobra"
+)
+
+const (
+	importReasonNotPyPI     = "not from PyPI"
+	importReasonUnparseable = "unparseable"
+)
+
+func (c *command) initImportPyPICmd(importCmd *cobra.Command) (err error) {
+	optionNameDev := "dev"
+
+	cmd := c.newImportCmd(
+		"pypi [FILE]",
+		"Track Python package dependencies",
+		`Track Python packages that are dependencies in a requirements file,
+pyproject.toml or Pipfile.lock, by default requirements.txt in the current
+directory.
+
+Requirements files are read with all files that are included with -r
+options. In pyproject.toml, dependencies of the project table (PEP 621) and
+Poetry dependencies are tracked. Development dependencies, optional
+dependencies and dependency groups are tracked only with the --dev flag, as
+well as develop packages from Pipfile.lock.
+
+Package names are normalized according to PEP 503. Requirements that are not
+installed from PyPI, like local paths and VCS URLs, are skipped.`,
+		func(cmd *cobra.Command, args []string) (projects []importProject, err error) {
+			if len(args) > 1 {
+				return nil, cmd.Help()
+			}
+			filename := "requirements.txt"
+			if len(args) == 1 {
+				filename = args[0]
+			}
+			dev, err := cmd.Flags().GetBool(optionNameDev)
+			if err != nil {
+				return nil, err
+			}
+
+			switch filepath.Base(filename) {
+			case "pyproject.toml":
+				projects, err = readPyProject(filename, dev)
+			case "Pipfile.lock":
+				projects, err = readPipfileLock(filename, dev)
+			default:
+				return readRequirementsFile(filename, make(map[string]struct{}))
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", filename, err)
+			}
+			return projects, nil
+		},
+	)
+
+	cmd.Flags().Bool(optionNameDev, false, "also track development and optional dependencies")
+
+	importCmd.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+var (
+	pythonRequirementRegex       = regexp.MustCompile(`^([A-Za-z0-9](?:[A-Za-z0-9._-]*[A-Za-z0-9])?)\s*(?:\[[^\]]*\])?\s*(.*)$`)
+	pythonPackageNameSeparators  = regexp.MustCompile(`[-_.]+`)
+	pythonRequirementOptionRegex = regexp.MustCompile(`^(-r|--requirement|-c|--constraint|-e|--editable)(?:\s*=\s*|\s+|$)(.*)$`)
+)
+
+// normalizePythonPackageName returns the package name normalized as
+// specified in PEP 503.
+func normalizePythonPackageName(name string) string {
+	return strings.ToLower(pythonPackageNameSeparators.ReplaceAllString(name, "-"))
+}
+
+// newPythonImportProject returns a project from a PEP 508 dependency
+// specification.
+func newPythonImportProject(requirement string) importProject {
+	requirement = strings.TrimSpace(requirement)
+	p := importProject{source: requirement}
+
+	for _, suffix := range []string{".whl", ".tar.gz", ".zip"} {
+		if strings.HasSuffix(requirement, suffix) {
+			p.reason = importReasonNotPyPI
+			return p
+		}
+	}
+
+	m := pythonRequirementRegex.FindStringSubmatch(requirement)
+	if m == nil {
+		if strings.Contains(requirement, "/") || strings.Contains(requirement, ":") {
+			p.reason = importReasonNotPyPI
+		} else {
+			p.reason = importReasonUnparseable
+		}
+		return p
+	}
+	name, rest := m[1], strings.TrimSpace(m[2])
+	switch {
+	case strings.HasPrefix(rest, "@"):
+		p.reason = importReasonNotPyPI
+		return p
+	case strings.ContainsAny(rest[:min(1, len(rest))], ":/.+"):
+		// Paths and URLs, like pkg/dir, https://example.com/pkg or
+		// git+https://example.com/pkg.git.
+		p.reason = importReasonNotPyPI
+		return p
+	case rest != "" && !strings.ContainsAny(rest[:1], "<>=!~;("):
+		p.reason = importReasonUnparseable
+		return p
+	}
+	p.source = name
+	p.provider, p.name = "pypi", normalizePythonPackageName(name)
+	if version, ok := strings.CutPrefix(rest, "=="); ok {
+		// Only exact versions, like ==2.31.0; python_version < "3.12".
+		version, _, _ = strings.Cut(version, ";")
+		if version = strings.TrimSpace(strings.TrimPrefix(version, "=")); !strings.ContainsAny(version, ",*") {
+			p.version = version
+		}
+	}
+	return p
+}
+
+// readRequirementsFile returns projects from a pip requirements file and all
+// files that it includes. Included files are tracked in the visited set to
+// avoid cycles.
+func readRequirementsFile(filename string, visited map[string]struct{}) (projects []importProject, err error) {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := visited[abs]; ok {
+		return nil, nil
+	}
+	visited[abs] = struct{}{}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	var continued string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := continued + scanner.Text()
+		if strings.HasSuffix(line, `\`) {
+			continued = strings.TrimSuffix(line, `\`)
+			continue
+		}
+		continued = ""
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if continued != "" {
+		lines = append(lines, continued)
+	}
+
+	for _, line := range lines {
+		if line = stripRequirementComment(line); line == "" {
+			continue
+		}
+
+		if m := pythonRequirementOptionRegex.FindStringSubmatch(line); m != nil {
+			switch m[1] {
+			case "-r", "--requirement":
+				include := strings.TrimSpace(m[2])
+				if !filepath.IsAbs(include) {
+					include = filepath.Join(filepath.Dir(filename), include)
+				}
+				p, err := readRequirementsFile(include, visited)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", filename, err)
+				}
+				projects = append(projects, p...)
+			case "-e", "--editable":
+				projects = append(projects, importProject{source: strings.TrimSpace(m[2]), reason: importReasonNotPyPI})
+			case "-c", "--constraint":
+				// Constraints files do not add requirements.
+			}
+			continue
+		}
+		if strings.HasPrefix(line, "-") {
+			// Global options, like --index-url.
+			continue
+		}
+
+		// Per-requirement options, like --hash.
+		if i := strings.Index(line, " --"); i >= 0 {
+			line = line[:i]
+		}
+		projects = append(projects, newPythonImportProject(line))
+	}
+	return projects, nil
+}
+
+// stripRequirementComment removes a comment which starts with # at the
+// beginning of the line or after a whitespace.
+func stripRequirementComment(line string) string {
+	for i := strings.Index(line, "#"); i >= 0; {
+		if i == 0 || line[i-1] == ' ' || line[i-1] == '\t' {
+			return strings.TrimSpace(line[:i])
+		}
+		j := strings.Index(line[i+1:], "#")
+		if j < 0 {
+			break
+		}
+		i += j + 1
+	}
+	return strings.TrimSpace(line)
+}
+
+// readPyProject returns projects from dependencies in a pyproject.toml file.
+func readPyProject(filename string, dev bool) (projects []importProject, err error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var pyproject struct {
+		Project struct {
+			Dependencies         []string            `toml:"dependencies"`
+			OptionalDependencies map[string][]string `toml:"optional-dependencies"`
+		} `toml:"project"`
+		DependencyGroups map[string][]interface{} `toml:"dependency-groups"`
+		Tool             struct {
+			Poetry struct {
+				Dependencies    map[string]interface{} `toml:"dependencies"`
+				DevDependencies map[string]interface{} `toml:"dev-dependencies"`
+				Group           map[string]struct {
+					Dependencies map[string]interface{} `toml:"dependencies"`
+				} `toml:"group"`
+			} `toml:"poetry"`
+		} `toml:"tool"`
+	}
+	if err := toml.Unmarshal(data, &pyproject); err != nil {
+		return nil, err
+	}
+
+	for _, r := range pyproject.Project.Dependencies {
+		projects = append(projects, newPythonImportProject(r))
+	}
+	projects = append(projects, newPythonTableImportProjects(pyproject.Tool.Poetry.Dependencies)...)
+
+	if !dev {
+		return projects, nil
+	}
+
+	for _, extra := range sortedKeys(pyproject.Project.OptionalDependencies) {
+		for _, r := range pyproject.Project.OptionalDependencies[extra] {
+			projects = append(projects, newPythonImportProject(r))
+		}
+	}
+	for _, group := range sortedKeys(pyproject.DependencyGroups) {
+		for _, r := range pyproject.DependencyGroups[group] {
+			// Tables in dependency groups are includes of other groups
+			// which are already handled.
+			if r, ok := r.(string); ok {
+				projects = append(projects, newPythonImportProject(r))
+			}
+		}
+	}
+	projects = append(projects, newPythonTableImportProjects(pyproject.Tool.Poetry.DevDependencies)...)
+	for _, group := range sortedKeys(pyproject.Tool.Poetry.Group) {
+		projects = append(projects, newPythonTableImportProjects(pyproject.Tool.Poetry.Group[group].Dependencies)...)
+	}
+	return projects, nil
+}
+
+// newPythonTableImportProjects returns projects from a table of package names
+// and their specifications, like Poetry dependencies and Pipfile.lock
+// packages, in the order of their names.
+func newPythonTableImportProjects(dependencies map[string]interface{}) (projects []importProject) {
+	for _, name := range sortedKeys(dependencies) {
+		if strings.EqualFold(name, "python") {
+			continue
+		}
+		if !isPythonIndexDependency(dependencies[name]) {
+			projects = append(projects, importProject{source: name, reason: importReasonNotPyPI})
+			continue
+		}
+		projects = append(projects, newPythonImportProject(name))
+	}
+	return projects
+}
+
+// isPythonIndexDependency returns false if the package specification
+// references a VCS repository, a path or a URL.
+func isPythonIndexDependency(spec interface{}) bool {
+	switch spec := spec.(type) {
+	case map[string]interface{}:
+		for _, key := range []string{"git", "hg", "svn", "bzr", "path", "file", "url"} {
+			if _, ok := spec[key]; ok {
+				return false
+			}
+		}
+	case []interface{}:
+		// Multiple constraints for different environments.
+		for _, s := range spec {
+			if !isPythonIndexDependency(s) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// readPipfileLock returns projects from the default, and optionally develop,
+// packages of a Pipfile.lock file.
+func readPipfileLock(filename string, dev bool) (projects []importProject, err error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock struct {
+		Default map[string]interface{} `json:"default"`
+		Develop map[string]interface{} `json:"develop"`
+	}
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, err
+	}
+
+	projects = newPythonTableImportProjects(lock.Default)
+	if dev {
+		projects = append(projects, newPythonTableImportProjects(lock.Develop)...)
+	}
+	return projects, nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestImportCmd_PyPI(t *testing.T) {
+	files := map[string]string{
+		"requirements.txt": `# Application requirements
+--index-url https://pypi.org/simple
+-r requirements-base.txt
+-c constraints.txt
+
+Django>=4.2,<5.0  # web framework
+requests[security]==2.31.0 \
+    --hash=sha256:58cd2187c01e70e6e26505bca751777aa9f2ee0b7f4300988b709f44e013003f
+zope.interface ; python_version >= "3.8"
+-e git+https://github.com/owner/project.git#egg=project
+mypkg @ https://example.com/mypkg-1.0.tar.gz
+./local/package
+not a requirement
+`,
+		"requirements-base.txt": `Ruamel_YAML.Clib~=0.2
+-r requirements.txt
+`,
+		"pyproject.toml": `[project]
+name = "app"
+dependencies = [
+  "httpx>=0.27",
+  "Pydantic[email]>=2",
+]
+
+[project.optional-dependencies]
+docs = ["Sphinx"]
+
+[dependency-groups]
+test = ["pytest>=8", {include-group = "docs"}]
+
+[tool.poetry.dependencies]
+python = "^3.11"
+celery = "^5.3"
+internal = {git = "https://github.com/owner/internal.git"}
+flask = {version = "^3.0", extras = ["async"]}
+
+[tool.poetry.group.dev.dependencies]
+black = "^24"
+`,
+		"Pipfile.lock": `{
+  "_meta": {"hash": {"sha256": "0"}},
+  "default": {
+    "numpy": {"version": "==1.26.4"},
+    "local-lib": {"path": "./local-lib", "editable": true}
+  },
+  "develop": {
+    "ruff": {"version": "==0.4.0"}
+  }
+}`,
+	}
+
+	for _, tc := range []struct {
+		name       string
+		args       []string
+		tracked    []string
+		wantOutput string
+		wantAdded  []string
+	}{
+		{
+			name:    "requirements",
+			args:    []string{"{dir}/requirements.txt"},
+			tracked: []string{"pypi django"},
+			wantOutput: "DEPENDENCY                                             PROVIDER   NAME               STATUS          \n" +
+				"Ruamel_YAML.Clib                                       pypi       ruamel-yaml-clib   added             \n" +
+				"Django                                                 pypi       django             already tracked   \n" +
+				"requests                                               pypi       requests           added             \n" +
+				"zope.interface                                         pypi       zope-interface     added             \n" +
+				"git+https://github.com/owner/project.git#egg=project                                 not from PyPI     \n" +
+				"mypkg @ https://example.com/mypkg-1.0.tar.gz                                         not from PyPI     \n" +
+				"./local/package                                                                      not from PyPI     \n" +
+				"not a requirement                                                                    unparseable       \n" +
+				"\n" +
+				"Projects: 3 added, 1 already tracked, 4 skipped.\n",
+			wantAdded: []string{"pypi ruamel-yaml-clib", "pypi requests", "pypi zope-interface"},
+		},
+		{
+			name:      "pyproject.toml",
+			args:      []string{"{dir}/pyproject.toml"},
+			wantAdded: []string{"pypi httpx", "pypi pydantic", "pypi celery", "pypi flask"},
+		},
+		{
+			name:      "pyproject.toml with dev",
+			args:      []string{"{dir}/pyproject.toml", "--dev"},
+			wantAdded: []string{"pypi httpx", "pypi pydantic", "pypi celery", "pypi flask", "pypi sphinx", "pypi pytest", "pypi black"},
+		},
+		{
+			name:      "Pipfile.lock",
+			args:      []string{"{dir}/Pipfile.lock"},
+			wantAdded: []string{"pypi numpy"},
+		},
+		{
+			name:      "Pipfile.lock with dev",
+			args:      []string{"{dir}/Pipfile.lock", "--dev"},
+			wantAdded: []string{"pypi numpy", "pypi ruff"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			gotOutput, gotAdded := runImportCmd(t, files, tc.tracked, append([]string{"pypi"}, tc.args...)...)
+			if tc.wantOutput != "" && gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+			if !reflect.DeepEqual(gotAdded, tc.wantAdded) {
+				t.Errorf("got added %q, want %q", gotAdded, tc.wantAdded)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+// runImportCmd writes files to a temporary directory and executes the import
+// command with arguments where "{dir}" is replaced with the directory path.
+func runImportCmd(t *testing.T, files map[string]string, tracked []string, args ...string) (output string, added []string) {
+	t.Helper()
+
+	output, s := runImportCmdWithService(t, files, tracked, args...)
+	return output, s.added
+}
+
+// runImportCmdWithService executes the import command as runImportCmd and
+// returns the projects service with recorded added projects and their tags.
+func runImportCmdWithService(t *testing.T, files map[string]string, tracked []string, args ...string) (output string, s *mockImportProjectsService) {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, data := range files {
+		filename := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(filename), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filename, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i, a := range args {
+		if a == "{dir}" {
+			args[i] = dir
+		} else if len(a) > 5 && a[:5] == "{dir}" {
+			args[i] = filepath.Join(dir, a[5:])
+		}
+	}
+
+	s = newMockImportProjectsService(tracked...)
+
+	var outputBuf bytes.Buffer
+	if err := newCommand(t,
+		cmd.WithArgs(append([]string{"import"}, args...)...),
+		cmd.WithOutput(&outputBuf),
+		cmd.WithProjectsService(s),
+	).Execute(); err != nil {
+		t.Fatal(err)
+	}
+	return outputBuf.String(), s
+}
+
+// mockImportProjectsService tracks projects by their provider and name.
+type mockImportProjectsService struct {
+	mockProjectsService
+	tracked    map[string]struct{}
+	added      []string
+	tags       map[string][]string
+	exclusions map[string][]newreleases.Exclusion
+}
+
+func newMockImportProjectsService(tracked ...string) *mockImportProjectsService {
+	s := &mockImportProjectsService{tracked: make(map[string]struct{}), tags: make(map[string][]string), exclusions: make(map[string][]newreleases.Exclusion)}
+	for _, p := range tracked {
+		s.tracked[p] = struct{}{}
+	}
+	return s
+}
+
+func (s *mockImportProjectsService) GetByName(ctx context.Context, provider, name string) (project *newreleases.Project, err error) {
+	if _, ok := s.tracked[provider+" "+name]; !ok {
+		return nil, newreleases.ErrNotFound
+	}
+	return &newreleases.Project{Provider: provider, Name: name}, nil
+}
+
+func (s *mockImportProjectsService) Add(ctx context.Context, provider, name string, o *newreleases.ProjectOptions) (project *newreleases.Project, err error) {
+	s.added = append(s.added, provider+" "+name)
+	s.tags[provider+" "+name] = o.TagIDs
+	s.exclusions[provider+" "+name] = o.Exclusions
+	s.tracked[provider+" "+name] = struct{}{}
+	return &newreleases.Project{Provider: provider, Name: name}, nil
+}
+
+func TestImportCmd_options(t *testing.T) {
+	s := newMockRecordingImportOptionsService()
+
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "go.mod")
+	if err := os.WriteFile(filename, []byte("module example.com/m\n\nrequire github.com/spf13/cobra v1.9.1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := newCommand(t,
+		cmd.WithArgs("import", "gomod", filename, "--tag", "33f1db7254b9", "--slack", "zetyksdfeymdsbe60td5gwgxt4", "--email", "daily"),
+		cmd.WithOutput(new(bytes.Buffer)),
+		cmd.WithProjectsService(s),
+	).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if s.options == nil {
+		t.Fatal("project not added")
+	}
+	if !reflect.DeepEqual(s.options.TagIDs, []string{"33f1db7254b9"}) {
+		t.Errorf("got tags %v, want %v", s.options.TagIDs, []string{"33f1db7254b9"})
+	}
+	if !reflect.DeepEqual(s.options.SlackIDs, []string{"zetyksdfeymdsbe60td5gwgxt4"}) {
+		t.Errorf("got slack channels %v, want %v", s.options.SlackIDs, []string{"zetyksdfeymdsbe60td5gwgxt4"})
+	}
+	if s.options.EmailNotification == nil || *s.options.EmailNotification != newreleases.EmailNotificationDaily {
+		t.Errorf("got email notification %v, want %v", s.options.EmailNotification, newreleases.EmailNotificationDaily)
+	}
+}
+
+type mockRecordingImportOptionsService struct {
+	*mockImportProjectsService
+	options *newreleases.ProjectOptions
+}
+
+func newMockRecordingImportOptionsService() *mockRecordingImportOptionsService {
+	return &mockRecordingImportOptionsService{mockImportProjectsService: newMockImportProjectsService()}
+}
+
+func (s *mockRecordingImportOptionsService) Add(ctx context.Context, provider, name string, o *newreleases.ProjectOptions) (project *newreleases.Project, err error) {
+	s.options = o
+	return s.mockImportProjectsService.Add(ctx, provider, name, o)
+}
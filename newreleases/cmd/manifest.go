@@ -0,0 +1,296 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+	"newreleases.io/newreleases"
+)
+
+// manifest describes the desired state of tracked projects. It is stored in
+// YAML or JSON format.
+type manifest struct {
+	Projects []manifestProject `yaml:"projects"`
+}
+
+// manifestProject holds all options of a tracked project. Options that are
+// not set are considered to be disabled.
+type manifestProject struct {
+	Provider           string              `yaml:"provider"`
+	Name               string              `yaml:"name"`
+	Email              string              `yaml:"email,omitempty"`
+	Slack              []string            `yaml:"slack,omitempty"`
+	Telegram           []string            `yaml:"telegram,omitempty"`
+	Discord            []string            `yaml:"discord,omitempty"`
+	HangoutsChat       []string            `yaml:"hangouts-chat,omitempty"`
+	MicrosoftTeams     []string            `yaml:"microsoft-teams,omitempty"`
+	Mattermost         []string            `yaml:"mattermost,omitempty"`
+	Rocketchat         []string            `yaml:"rocketchat,omitempty"`
+	Matrix             []string            `yaml:"matrix,omitempty"`
+	Webhook            []string            `yaml:"webhook,omitempty"`
+	Exclusions         []manifestExclusion `yaml:"regex-exclude,omitempty"`
+	ExcludePrereleases bool                `yaml:"exclude-prereleases,omitempty"`
+	ExcludeUpdated     bool                `yaml:"exclude-updated,omitempty"`
+	Note               string              `yaml:"note,omitempty"`
+	Tags               []string            `yaml:"tags,omitempty"`
+}
+
+type manifestExclusion struct {
+	Value   string `yaml:"value"`
+	Inverse bool   `yaml:"inverse,omitempty"`
+}
+
+func readManifestFile(filename string) (m *manifest, err error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m, err = readManifest(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return m, nil
+}
+
+func readManifest(r io.Reader) (m *manifest, err error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	// The list of projects is required, even if it is empty, so that an
+	// empty or truncated file is not taken as a manifest without projects.
+	var v struct {
+		Projects *[]manifestProject `yaml:"projects"`
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&v); err != nil {
+		if err == io.EOF {
+			return nil, errors.New("manifest is empty")
+		}
+		return nil, err
+	}
+	if v.Projects == nil {
+		return nil, errors.New("projects are not specified")
+	}
+	m = &manifest{Projects: *v.Projects}
+	return m, m.validate()
+}
+
+func (m *manifest) validate() (err error) {
+	seen := make(map[string]struct{})
+	for i, p := range m.Projects {
+		if p.Provider == "" || p.Name == "" {
+			return fmt.Errorf("project %v: provider and name are required", i+1)
+		}
+		key := p.key()
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("project %s %s: defined more than once", p.Provider, p.Name)
+		}
+		seen[key] = struct{}{}
+		switch p.Email {
+		case "", "none", "instant", "hourly", "daily", "weekly":
+		default:
+			return fmt.Errorf("project %s %s: invalid email notification %q", p.Provider, p.Name, p.Email)
+		}
+	}
+	return nil
+}
+
+func (p manifestProject) key() string {
+	return p.Provider + "/" + p.Name
+}
+
+// options returns project options that set every option, so that options
+// not present in the manifest are removed on update.
+func (p manifestProject) options() *newreleases.ProjectOptions {
+	email := newreleases.EmailNotification(p.email())
+	exclusions := make([]newreleases.Exclusion, 0, len(p.Exclusions))
+	for _, e := range p.Exclusions {
+		exclusions = append(exclusions, newreleases.Exclusion{Value: e.Value, Inverse: e.Inverse})
+	}
+	excludePrereleases := p.ExcludePrereleases
+	excludeUpdated := p.ExcludeUpdated
+	note := p.Note
+	return &newreleases.ProjectOptions{
+		EmailNotification:      &email,
+		SlackIDs:               nonNilStrings(p.Slack),
+		TelegramChatIDs:        nonNilStrings(p.Telegram),
+		DiscordIDs:             nonNilStrings(p.Discord),
+		HangoutsChatWebhookIDs: nonNilStrings(p.HangoutsChat),
+		MSTeamsWebhookIDs:      nonNilStrings(p.MicrosoftTeams),
+		MattermostWebhookIDs:   nonNilStrings(p.Mattermost),
+		RocketchatWebhookIDs:   nonNilStrings(p.Rocketchat),
+		MatrixRoomIDs:          nonNilStrings(p.Matrix),
+		WebhookIDs:             nonNilStrings(p.Webhook),
+		Exclusions:             exclusions,
+		ExcludePrereleases:     &excludePrereleases,
+		ExcludeUpdated:         &excludeUpdated,
+		Note:                   &note,
+		TagIDs:                 nonNilStrings(p.Tags),
+	}
+}
+
+func (p manifestProject) email() string {
+	if p.Email == "" {
+		return string(newreleases.EmailNotificationNone)
+	}
+	return p.Email
+}
+
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return make([]string, 0)
+	}
+	return s
+}
+
+// newManifestProject returns manifest representation of a tracked project.
+func newManifestProject(p newreleases.Project) manifestProject {
+	m := manifestProject{
+		Provider:           p.Provider,
+		Name:               p.Name,
+		Slack:              p.SlackIDs,
+		Telegram:           p.TelegramChatIDs,
+		Discord:            p.DiscordIDs,
+		HangoutsChat:       p.HangoutsChatWebhookIDs,
+		MicrosoftTeams:     p.MSTeamsWebhookIDs,
+		Mattermost:         p.MattermostWebhookIDs,
+		Rocketchat:         p.RocketchatWebhookIDs,
+		Matrix:             p.MatrixRoomIDs,
+		Webhook:            p.WebhookIDs,
+		ExcludePrereleases: p.ExcludePrereleases,
+		ExcludeUpdated:     p.ExcludeUpdated,
+		Note:               p.Note,
+		Tags:               p.TagIDs,
+	}
+	if p.EmailNotification != newreleases.EmailNotificationNone {
+		m.Email = string(p.EmailNotification)
+	}
+	for _, e := range p.Exclusions {
+		m.Exclusions = append(m.Exclusions, manifestExclusion{Value: e.Value, Inverse: e.Inverse})
+	}
+	return m
+}
+
+type manifestField struct {
+	name  string
+	value string
+}
+
+// fields returns names and comparable string values of all project options.
+func (p manifestProject) fields() []manifestField {
+	exclusions := make([]string, 0, len(p.Exclusions))
+	for _, e := range p.Exclusions {
+		if e.Inverse {
+			exclusions = append(exclusions, e.Value+" (inverse)")
+		} else {
+			exclusions = append(exclusions, e.Value)
+		}
+	}
+	return []manifestField{
+		{"email", p.email()},
+		{"slack", formatManifestList(p.Slack)},
+		{"telegram", formatManifestList(p.Telegram)},
+		{"discord", formatManifestList(p.Discord)},
+		{"hangouts-chat", formatManifestList(p.HangoutsChat)},
+		{"microsoft-teams", formatManifestList(p.MicrosoftTeams)},
+		{"mattermost", formatManifestList(p.Mattermost)},
+		{"rocketchat", formatManifestList(p.Rocketchat)},
+		{"matrix", formatManifestList(p.Matrix)},
+		{"webhook", formatManifestList(p.Webhook)},
+		{"regex-exclude", formatManifestList(exclusions)},
+		{"exclude-prereleases", yesNo(p.ExcludePrereleases)},
+		{"exclude-updated", yesNo(p.ExcludeUpdated)},
+		{"note", fmt.Sprintf("%q", p.Note)},
+		{"tags", formatManifestList(p.Tags)},
+	}
+}
+
+// formatManifestList formats a list in the same way regardless of the order
+// of its elements.
+func formatManifestList(l []string) string {
+	s := make([]string, len(l))
+	copy(s, l)
+	sort.Strings(s)
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
+type fieldChange struct {
+	name string
+	from string
+	to   string
+}
+
+// diffManifestProjects returns options that are different between the current
+// and the desired project.
+func diffManifestProjects(current, desired manifestProject) (changes []fieldChange) {
+	currentFields := current.fields()
+	for i, f := range desired.fields() {
+		if c := currentFields[i]; c.value != f.value {
+			changes = append(changes, fieldChange{name: f.name, from: c.value, to: f.value})
+		}
+	}
+	return changes
+}
+
+type planAction string
+
+const (
+	planActionAdd    planAction = "add"
+	planActionUpdate planAction = "update"
+	planActionRemove planAction = "remove"
+)
+
+type planEntry struct {
+	action  planAction
+	project manifestProject
+	changes []fieldChange
+}
+
+// newPlan returns changes required for tracked projects to match the
+// manifest. Additions and updates are in the manifest order, followed by
+// removals of projects that are not in the manifest, only if prune is true,
+// in the order of tracked projects.
+func newPlan(m *manifest, projects []newreleases.Project, prune bool) (plan []planEntry) {
+	current := make(map[string]manifestProject, len(projects))
+	for _, p := range projects {
+		mp := newManifestProject(p)
+		current[mp.key()] = mp
+	}
+	desired := make(map[string]struct{}, len(m.Projects))
+	for _, p := range m.Projects {
+		desired[p.key()] = struct{}{}
+		c, ok := current[p.key()]
+		if !ok {
+			plan = append(plan, planEntry{action: planActionAdd, project: p})
+			continue
+		}
+		if changes := diffManifestProjects(c, p); len(changes) > 0 {
+			plan = append(plan, planEntry{action: planActionUpdate, project: p, changes: changes})
+		}
+	}
+	if !prune {
+		return plan
+	}
+	for _, p := range projects {
+		mp := newManifestProject(p)
+		if _, ok := desired[mp.key()]; !ok {
+			plan = append(plan, planEntry{action: planActionRemove, project: mp})
+		}
+	}
+	return plan
+}
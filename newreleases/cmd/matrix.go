@@ -25,6 +25,10 @@ func (c *command) initMatrixCmd() (err error) {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, rooms); ok || err != nil {
+				return err
+			}
+
 			if len(rooms) == 0 {
 				cmd.Println("No Matrix Rooms found.")
 				return nil
@@ -25,6 +25,10 @@ func (c *command) initMattermostCmd() (err error) {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, webhooks); ok || err != nil {
+				return err
+			}
+
 			if len(webhooks) == 0 {
 				cmd.Println("No Mattermost Webhooks found.")
 				return nil
@@ -0,0 +1,279 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"newreleases.io/newreleases"
+)
+
+const (
+	outdatedStatusOutdated = "outdated"
+	outdatedStatusUpToDate = "up to date"
+)
+
+// outdatedManifestFiles are files that are looked up in the current directory
+// if no file is specified, in the order of preference.
+var outdatedManifestFiles = []string{"go.mod", "package.json", "requirements.txt", "Dockerfile"}
+
+func (c *command) initOutdatedCmd() (err error) {
+	cmd := &cobra.Command{
+		Use:   "outdated [FILE]",
+		Short: "Report dependencies that have newer releases",
+		Long: `Report dependencies that have newer releases.
+
+Dependencies and their current versions are read from a go.mod, package.json,
+requirements.txt or Dockerfile, by default the first of them that is found in
+the current directory. Dependencies are mapped to projects in the same way as
+with the import command and their latest releases are compared with the
+current versions by semantic versioning. The number of releases behind is the
+number of releases with versions greater than the current one, excluding
+pre-releases and excluded releases. Projects should be tracked for their
+releases to be known, for example with the import command.
+
+Dependencies without a pinned version, like version ranges in package.json,
+including ^ and ~ ranges, or requirements without the == operator, are
+skipped.
+
+The exit code is 0 if all dependencies are up to date, 2 if any of them is
+outdated and 1 on any error.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			var filename string
+			switch len(args) {
+			case 0:
+				for _, f := range outdatedManifestFiles {
+					if _, err := os.Stat(f); err == nil {
+						filename = f
+						break
+					} else if !errors.Is(err, fs.ErrNotExist) {
+						return err
+					}
+				}
+				if filename == "" {
+					return errors.New("no supported file found in the current directory")
+				}
+			case 1:
+				filename = args[0]
+			default:
+				return cmd.Help()
+			}
+
+			projects, err := readOutdatedDependencies(filename)
+			if err != nil {
+				return err
+			}
+
+			dependencies := make([]outdatedDependency, 0, len(projects))
+			var outdated, upToDate, skipped int
+			for _, p := range projects {
+				d, err := c.outdatedDependency(p)
+				if err != nil {
+					return fmt.Errorf("%s %s: %w", p.provider, p.name, err)
+				}
+				switch d.Status {
+				case outdatedStatusOutdated:
+					outdated++
+				case outdatedStatusUpToDate:
+					upToDate++
+				default:
+					skipped++
+				}
+				dependencies = append(dependencies, d)
+			}
+
+			if ok, err := c.writeOutput(cmd, dependencies); ok || err != nil {
+				if err == nil && outdated > 0 {
+					return &ExitError{Code: exitCodeOutdated}
+				}
+				return err
+			}
+
+			if len(dependencies) == 0 {
+				cmd.Println("No dependencies found.")
+				return nil
+			}
+
+			printOutdatedTable(cmd, dependencies)
+
+			cmd.Println()
+			cmd.Printf("Dependencies: %v outdated, %v up to date, %v skipped.\n", outdated, upToDate, skipped)
+
+			if outdated > 0 {
+				return &ExitError{Code: exitCodeOutdated}
+			}
+			return nil
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := addClientConfigOptions(cmd, c.config); err != nil {
+				return err
+			}
+			return c.setReleasesService(cmd, args)
+		},
+	}
+
+	c.root.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+// outdatedDependency is a dependency with its current and latest versions.
+type outdatedDependency struct {
+	Dependency string `json:"dependency"`
+	Provider   string `json:"provider,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Current    string `json:"current,omitempty"`
+	Latest     string `json:"latest,omitempty"`
+	Behind     int    `json:"behind"`
+	Status     string `json:"status"`
+}
+
+// readOutdatedDependencies returns dependencies from a supported file,
+// recognized by its name, with their current versions.
+func readOutdatedDependencies(filename string) (projects []importProject, err error) {
+	base := filepath.Base(filename)
+	switch {
+	case base == "go.mod":
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		requirements, err := parseGoModRequirements(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		for _, r := range requirements {
+			if r.indirect {
+				continue
+			}
+			p := newGoModuleImportProject(r.path)
+			p.version = r.version
+			projects = append(projects, p)
+		}
+		return projects, nil
+	case base == "package.json":
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		projects, err = readNPMPackageJSON(f, false)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		return projects, nil
+	case filepath.Ext(base) == ".txt":
+		return readRequirementsFile(filename, make(map[string]struct{}))
+	case imageSourceKind(filename) == imageSourceDockerfile:
+		images, err := readImagesFile(filename, imageSourceDockerfile, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range images {
+			projects = append(projects, i.project)
+		}
+		return projects, nil
+	}
+	return nil, fmt.Errorf("%s: unsupported file", filename)
+}
+
+// outdatedDependency compares the current version of the dependency with the
+// latest release of its project.
+func (c *command) outdatedDependency(p importProject) (d outdatedDependency, err error) {
+	d = outdatedDependency{
+		Dependency: p.source,
+		Provider:   p.provider,
+		Name:       p.name,
+		Current:    p.version,
+	}
+	if p.reason != "" {
+		d.Status = p.reason
+		return d, nil
+	}
+	if p.version == "" {
+		d.Status = "not pinned"
+		return d, nil
+	}
+	current, ok := parseSemanticVersion(p.version)
+	if !ok {
+		d.Status = "unsupported version"
+		return d, nil
+	}
+
+	ctx, cancel := newClientContext(c.config)
+	defer cancel()
+
+	release, err := c.releasesService.GetLatestByProjectName(ctx, p.provider, p.name)
+	if err != nil {
+		if err == newreleases.ErrNotFound {
+			d.Status = "project not found"
+			return d, nil
+		}
+		return d, err
+	}
+	if release == nil {
+		d.Status = "no releases"
+		return d, nil
+	}
+	d.Latest = release.Version
+
+	latest, ok := parseSemanticVersion(release.Version)
+	if !ok {
+		d.Status = "unsupported version"
+		return d, nil
+	}
+	if latest.compare(current) <= 0 {
+		d.Status = outdatedStatusUpToDate
+		return d, nil
+	}
+
+	newer, _, err := listNewerReleases(ctx, func(ctx context.Context, page int) ([]newreleases.Release, int, error) {
+		return c.releasesService.ListByProjectName(ctx, p.provider, p.name, page)
+	}, current)
+	if err != nil {
+		return d, err
+	}
+	// Only newer releases that are not excluded, and not pre-releases unless
+	// the current version is one, make the dependency outdated.
+	includePrereleases := current.isPrerelease()
+	for _, r := range newer {
+		if r.IsExcluded {
+			continue
+		}
+		if v, _ := parseSemanticVersion(r.Version); !includePrereleases && (r.IsPrerelease || v.isPrerelease()) {
+			continue
+		}
+		d.Behind++
+	}
+	if d.Behind == 0 {
+		d.Status = outdatedStatusUpToDate
+		return d, nil
+	}
+	d.Status = outdatedStatusOutdated
+	return d, nil
+}
+
+func printOutdatedTable(cmd *cobra.Command, dependencies []outdatedDependency) {
+	table := newTable(cmd.OutOrStdout())
+	table.SetHeader([]string{"Dependency", "Provider", "Name", "Current", "Latest", "Behind", "Status"})
+	for _, d := range dependencies {
+		var behind string
+		if d.Status == outdatedStatusOutdated {
+			behind = strconv.Itoa(d.Behind)
+		}
+		table.Append([]string{d.Dependency, d.Provider, d.Name, d.Current, d.Latest, behind, d.Status})
+	}
+	table.Render()
+}
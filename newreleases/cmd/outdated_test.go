@@ -0,0 +1,243 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+func TestOutdatedCmd(t *testing.T) {
+	files := map[string]string{
+		"go.mod": `module example.com/app
+
+go 1.22
+
+require (
+	github.com/spf13/cobra v1.7.0
+	golang.org/x/text v0.14.0
+	gopkg.in/yaml.v3 v3.0.1
+	example.com/private v1.0.0
+	github.com/untracked/module v1.0.0
+	github.com/spf13/pflag v1.0.5 // indirect
+)
+`,
+		"package.json": `{
+  "dependencies": {
+    "vue": "3.4.0",
+    "lodash": ">=4 <5"
+  }
+}`,
+		"range/package.json": `{
+  "dependencies": {
+    "react": "^18.2.0"
+  }
+}`,
+		"requirements.txt": `Django==4.2.0
+requests>=2
+`,
+		"Dockerfile": `FROM golang:1.22 AS build
+FROM alpine
+`,
+		"up-to-date/go.mod": `module example.com/app
+
+require github.com/spf13/cobra v1.8.1
+`,
+		"prerelease/go.mod": `module example.com/app
+
+require github.com/spf13/viper v1.18.0
+`,
+	}
+
+	releases := map[string][]newreleases.Release{
+		"github spf13/cobra": {
+			{Version: "v1.9.0-rc.1", IsPrerelease: true},
+			{Version: "v1.8.1"},
+			{Version: "v1.8.0"},
+			{Version: "v1.7.1-excluded", IsExcluded: true},
+			{Version: "v1.7.0"},
+			{Version: "v1.6.1"},
+		},
+		"github spf13/viper": {
+			{Version: "v1.19.0-beta.1"},
+			{Version: "v1.18.0"},
+		},
+		"github golang/text": {
+			{Version: "v0.14.0"},
+		},
+		"github go-yaml/yaml": {
+			{Version: "v3.0.1"},
+		},
+		"npm vue": {
+			{Version: "3.4.21"},
+			{Version: "3.4.20"},
+			{Version: "3.3.0"},
+		},
+		"npm react": {
+			{Version: "18.3.1"},
+			{Version: "18.2.0"},
+		},
+		"pypi django": {
+			{Version: "4.2.2"},
+			{Version: "5.0.1"},
+			{Version: "4.2.1"},
+			{Version: "5.0.0"},
+			{Version: "4.2.0"},
+		},
+		"dockerhub library/golang": {
+			{Version: "1.22"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name         string
+		file         string
+		wantOutput   string
+		wantExitCode int
+	}{
+		{
+			name: "go.mod",
+			file: "go.mod",
+			wantOutput: "DEPENDENCY                    PROVIDER   NAME               CURRENT   LATEST    BEHIND   STATUS                    \n" +
+				"github.com/spf13/cobra        github     spf13/cobra        v1.7.0    v1.8.1    2        outdated                    \n" +
+				"golang.org/x/text             github     golang/text        v0.14.0   v0.14.0            up to date                  \n" +
+				"gopkg.in/yaml.v3              github     go-yaml/yaml       v3.0.1    v3.0.1             up to date                  \n" +
+				"example.com/private                                         v1.0.0                       unknown source repository   \n" +
+				"github.com/untracked/module   github     untracked/module   v1.0.0                       project not found           \n" +
+				"\n" +
+				"Dependencies: 1 outdated, 2 up to date, 2 skipped.\n",
+			wantExitCode: cmd.ExitCodeOutdated,
+		},
+		{
+			name: "package.json",
+			file: "package.json",
+			wantOutput: "DEPENDENCY   PROVIDER   NAME     CURRENT   LATEST   BEHIND   STATUS     \n" +
+				"lodash       npm        lodash                               not pinned   \n" +
+				"vue          npm        vue      3.4.0     3.4.21   2        outdated     \n" +
+				"\n" +
+				"Dependencies: 1 outdated, 0 up to date, 1 skipped.\n",
+			wantExitCode: cmd.ExitCodeOutdated,
+		},
+		{
+			name: "package.json range",
+			file: "range/package.json",
+			wantOutput: "DEPENDENCY   PROVIDER   NAME    CURRENT   LATEST   BEHIND   STATUS     \n" +
+				"react        npm        react                               not pinned   \n" +
+				"\n" +
+				"Dependencies: 0 outdated, 0 up to date, 1 skipped.\n",
+		},
+		{
+			name: "requirements.txt",
+			file: "requirements.txt",
+			wantOutput: "DEPENDENCY   PROVIDER   NAME       CURRENT   LATEST   BEHIND   STATUS     \n" +
+				"Django       pypi       django     4.2.0     4.2.2    4        outdated     \n" +
+				"requests     pypi       requests                               not pinned   \n" +
+				"\n" +
+				"Dependencies: 1 outdated, 0 up to date, 1 skipped.\n",
+			wantExitCode: cmd.ExitCodeOutdated,
+		},
+		{
+			name: "Dockerfile",
+			file: "Dockerfile",
+			wantOutput: "DEPENDENCY    PROVIDER    NAME             CURRENT   LATEST   BEHIND   STATUS     \n" +
+				"golang:1.22   dockerhub   library/golang   1.22      1.22              up to date   \n" +
+				"alpine        dockerhub   library/alpine                               not pinned   \n" +
+				"\n" +
+				"Dependencies: 0 outdated, 1 up to date, 1 skipped.\n",
+		},
+		{
+			name: "up to date",
+			file: "up-to-date/go.mod",
+			wantOutput: "DEPENDENCY               PROVIDER   NAME          CURRENT   LATEST   BEHIND   STATUS     \n" +
+				"github.com/spf13/cobra   github     spf13/cobra   v1.8.1    v1.8.1            up to date   \n" +
+				"\n" +
+				"Dependencies: 0 outdated, 1 up to date, 0 skipped.\n",
+		},
+		{
+			name: "newer pre-release",
+			file: "prerelease/go.mod",
+			wantOutput: "DEPENDENCY               PROVIDER   NAME          CURRENT   LATEST           BEHIND   STATUS     \n" +
+				"github.com/spf13/viper   github     spf13/viper   v1.18.0   v1.19.0-beta.1            up to date   \n" +
+				"\n" +
+				"Dependencies: 0 outdated, 1 up to date, 0 skipped.\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, data := range files {
+				filename := filepath.Join(dir, name)
+				if err := os.MkdirAll(filepath.Dir(filename), 0o700); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(filename, []byte(data), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var outputBuf bytes.Buffer
+			err := newCommand(t,
+				cmd.WithArgs("outdated", filepath.Join(dir, tc.file)),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithReleasesService(newMockProjectReleasesService(releases)),
+			).Execute()
+			if tc.wantExitCode != 0 {
+				var e *cmd.ExitError
+				if !errors.As(err, &e) || e.Code != tc.wantExitCode {
+					t.Fatalf("got error %v, want exit code %v", err, tc.wantExitCode)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			if gotOutput := outputBuf.String(); gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+		})
+	}
+}
+
+// mockProjectReleasesService returns releases of projects by their provider
+// and name, with two releases on every page.
+type mockProjectReleasesService struct {
+	mockReleasesService
+	releases map[string][]newreleases.Release
+}
+
+func newMockProjectReleasesService(releases map[string][]newreleases.Release) (s mockProjectReleasesService) {
+	return mockProjectReleasesService{releases: releases}
+}
+
+func (s mockProjectReleasesService) ListByProjectName(ctx context.Context, provider, projectName string, page int) (releases []newreleases.Release, lastPage int, err error) {
+	r, ok := s.releases[provider+" "+projectName]
+	if !ok {
+		return nil, 0, newreleases.ErrNotFound
+	}
+	lastPage = (len(r) + 1) / 2
+	if page > lastPage {
+		return nil, lastPage, nil
+	}
+	return r[(page-1)*2 : min(page*2, len(r))], lastPage, nil
+}
+
+func (s mockProjectReleasesService) GetLatestByProjectName(ctx context.Context, provider, projectName string) (release *newreleases.Release, err error) {
+	r, ok := s.releases[provider+" "+projectName]
+	if !ok {
+		return nil, newreleases.ErrNotFound
+	}
+	for _, release := range r {
+		if !release.IsPrerelease && !release.IsExcluded {
+			return &release, nil
+		}
+	}
+	return nil, nil
+}
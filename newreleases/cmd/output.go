@@ -0,0 +1,119 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputFormatTable = "table"
+	outputFormatJSON  = "json"
+	outputFormatYAML  = "yaml"
+)
+
+// outputFormat returns the output format from the command line flag, or from
+// the configuration if the flag is not set.
+func (c *command) outputFormat() (format string) {
+	if c.root.PersistentFlags().Changed(optionNameOutput) {
+		return c.output
+	}
+	if v := c.config.GetString(optionNameOutput); v != "" {
+		return v
+	}
+	return c.output
+}
+
+func validateOutputFormat(format string) (err error) {
+	switch format {
+	case outputFormatTable, outputFormatJSON, outputFormatYAML:
+		return nil
+	}
+	return fmt.Errorf("unsupported output format %q", format)
+}
+
+// writeOutput encodes v to the command output if a template or a
+// machine-readable output format is selected. It returns false if the default
+// table output should be printed instead.
+func (c *command) writeOutput(cmd *cobra.Command, v interface{}) (written bool, err error) {
+	if c.template != nil {
+		if err := writeTemplate(cmd.OutOrStdout(), c.template, v); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+
+	var data []byte
+	switch c.outputFormat() {
+	case outputFormatJSON:
+		data, err = json.MarshalIndent(nonNilSlice(v), "", "  ")
+		if err != nil {
+			return false, err
+		}
+		data = append(data, '\n')
+	case outputFormatYAML:
+		data, err = marshalYAML(nonNilSlice(v))
+		if err != nil {
+			return false, err
+		}
+	default:
+		return false, nil
+	}
+	if _, err := cmd.OutOrStdout().Write(data); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// writeNotFound prints the message for a result that is not found, or encodes
+// null if a machine-readable output format is selected, so that the output
+// can always be decoded.
+func (c *command) writeNotFound(cmd *cobra.Command, message string) (err error) {
+	if c.template == nil {
+		if ok, err := c.writeOutput(cmd, nil); ok || err != nil {
+			return err
+		}
+	}
+	cmd.Println(message)
+	return nil
+}
+
+// nonNilSlice replaces a nil slice with an empty one, so that empty results
+// are encoded as empty arrays.
+func nonNilSlice(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Slice && rv.IsNil() {
+		return reflect.MakeSlice(rv.Type(), 0, 0).Interface()
+	}
+	return v
+}
+
+// marshalYAML encodes v as YAML using the same field names and order as its
+// JSON encoding.
+func marshalYAML(v interface{}) (data []byte, err error) {
+	data, err = json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var n yaml.Node
+	if err := yaml.Unmarshal(data, &n); err != nil {
+		return nil, err
+	}
+	resetYAMLStyle(&n)
+	return yaml.Marshal(&n)
+}
+
+func resetYAMLStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		resetYAMLStyle(c)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+func TestOutput_JSON(t *testing.T) {
+	projects := []newreleases.Project{minimalProject, fullProject}
+
+	var outputBuf bytes.Buffer
+	if err := newCommand(t,
+		cmd.WithArgs("project", "list", "--output", "json"),
+		cmd.WithOutput(&outputBuf),
+		cmd.WithProjectsService(newMockProjectsService(2, nil, projects)),
+	).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []newreleases.Project
+	if err := json.Unmarshal(outputBuf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, projects) {
+		t.Errorf("got projects %+v, want %+v", got, projects)
+	}
+}
+
+func TestOutput_empty(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		args       []string
+		wantOutput string
+	}{
+		{
+			name:       "json",
+			args:       []string{"project", "list", "-o", "json"},
+			wantOutput: "[]\n",
+		},
+		{
+			name:       "yaml",
+			args:       []string{"project", "list", "-o", "yaml"},
+			wantOutput: "[]\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var outputBuf bytes.Buffer
+			if err := newCommand(t,
+				cmd.WithArgs(tc.args...),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithProjectsService(newMockProjectsService(1, nil)),
+			).Execute(); err != nil {
+				t.Fatal(err)
+			}
+
+			if gotOutput := outputBuf.String(); gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+		})
+	}
+}
+
+func TestOutput_notFound(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		args       []string
+		wantOutput string
+	}{
+		{
+			name:       "json",
+			args:       []string{"tag", "get", "33f1db7254b9", "-o", "json"},
+			wantOutput: "null\n",
+		},
+		{
+			name:       "yaml",
+			args:       []string{"tag", "get", "33f1db7254b9", "-o", "yaml"},
+			wantOutput: "null\n",
+		},
+		{
+			name:       "table",
+			args:       []string{"tag", "get", "33f1db7254b9"},
+			wantOutput: "Tag not found.\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var outputBuf bytes.Buffer
+			if err := newCommand(t,
+				cmd.WithArgs(tc.args...),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithTagsService(newMockTagsService(nil, nil)),
+			).Execute(); err != nil {
+				t.Fatal(err)
+			}
+
+			if gotOutput := outputBuf.String(); gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+		})
+	}
+}
+
+func TestOutput_YAML(t *testing.T) {
+	var outputBuf bytes.Buffer
+	if err := newCommand(t,
+		cmd.WithArgs("tag", "list", "--output", "yaml"),
+		cmd.WithOutput(&outputBuf),
+		cmd.WithTagsService(newMockTagsService([]newreleases.Tag{
+			{ID: "33f1db7254b9", Name: "Cool"},
+			{ID: "123456", Name: "Awesome"},
+		}, nil)),
+	).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	wantOutput := "- id: 33f1db7254b9\n  name: Cool\n- id: \"123456\"\n  name: Awesome\n"
+	if gotOutput := outputBuf.String(); gotOutput != wantOutput {
+		t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+	}
+}
+
+func TestOutput_invalid(t *testing.T) {
+	err := newCommand(t,
+		cmd.WithArgs("tag", "list", "--output", "xml"),
+		cmd.WithTagsService(newMockTagsService(nil, nil)),
+	).Execute()
+	if err == nil || err.Error() != `unsupported output format "xml"` {
+		t.Fatalf("got error %v, want unsupported output format", err)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	optionNameAll         = "all"
+	optionNameLimit       = "limit"
+	optionNameConcurrency = "concurrency"
+
+	defaultConcurrency = 4
+)
+
+// pageLister returns elements on a page and the number of the last page.
+type pageLister[T any] func(ctx context.Context, page int) (elements []T, lastPage int, err error)
+
+// listAllPages gets elements from all pages, or, if limit is greater than
+// zero, from as many pages as needed to collect limit elements. The first
+// page is requested to find out the number of the last page, and all other
+// pages are requested concurrently, at most concurrency of them at the same
+// time. Elements are returned in the order of pages. Requests that are in
+// progress are canceled on the first error.
+func listAllPages[T any](ctx context.Context, limit, concurrency int, list pageLister[T]) (elements []T, err error) {
+	elements, lastPage, err := list(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(elements) > 0 {
+		if n := (limit + len(elements) - 1) / len(elements); n < lastPage {
+			lastPage = n
+		}
+	}
+	if lastPage <= 1 {
+		return limitElements(elements, limit), nil
+	}
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		pages    = make([][]T, lastPage-1)
+		sem      = make(chan struct{}, concurrency)
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+loop:
+	for page := 2; page <= lastPage; page++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break loop
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			e, _, err := list(ctx, page)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			pages[page-2] = e
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, e := range pages {
+		elements = append(elements, e...)
+	}
+	return limitElements(elements, limit), nil
+}
+
+// limitElements returns at most limit first elements, or all of them if limit
+// is not greater than zero.
+func limitElements[T any](elements []T, limit int) []T {
+	if limit > 0 && len(elements) > limit {
+		return elements[:limit]
+	}
+	return elements
+}
@@ -38,21 +38,28 @@ func (c *command) initProjectCmd() (err error) {
 	if err := c.initProjectRemoveCmd(cmd); err != nil {
 		return err
 	}
+	if err := c.initProjectCopyCmd(cmd); err != nil {
+		return err
+	}
 
 	c.root.AddCommand(cmd)
 	return nil
 }
 
 func (c *command) setProjectsService(cmd *cobra.Command, args []string) (err error) {
-	if c.projectsService != nil {
-		return nil
-	}
-	client, err := c.getClient(cmd)
-	if err != nil {
-		return err
+	if c.projectsService == nil {
+		client, err := c.getClient(cmd)
+		if err != nil {
+			return err
+		}
+		p, err := newRetryPolicy(cmd)
+		if err != nil {
+			return err
+		}
+		c.projectsService = newRetryingProjectsService(client.Projects, p)
 	}
-	c.projectsService = client.Projects
-	return nil
+	c.projectsService, err = c.cachedProjectsService(cmd, c.projectsService)
+	return err
 }
 
 type projectsService interface {
@@ -67,6 +74,15 @@ type projectsService interface {
 	DeleteByName(ctx context.Context, provider, name string) (err error)
 }
 
+// listAllProjects returns tracked projects from all pages.
+func listAllProjects(ctx context.Context, s projectsService, o newreleases.ProjectListOptions) (projects []newreleases.Project, err error) {
+	return listAllPages(ctx, 0, defaultConcurrency, func(ctx context.Context, page int) ([]newreleases.Project, int, error) {
+		o := o
+		o.Page = page
+		return s.List(ctx, o)
+	})
+}
+
 func printProjectsTable(cmd *cobra.Command, projects []newreleases.Project) {
 	table := newTable(cmd.OutOrStdout())
 
@@ -9,28 +9,29 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"newreleases.io/newreleases"
 )
 
-func (c *command) initProjectAddCmd(projectCmd *cobra.Command) (err error) {
-	var (
-		optionNameEmail              = "email"
-		optionNameSlack              = "slack"
-		optionNameTelegram           = "telegram"
-		optionNameDiscord            = "discord"
-		optionNameHangoutsChat       = "hangouts-chat"
-		optionNameMicrosoftTeams     = "microsoft-teams"
-		optionNameMattermost         = "mattermost"
-		optionNameRocketchat         = "rocketchat"
-		optionNameMatrix             = "matrix"
-		optionNameWebhook            = "webhook"
-		optionNameExclusions         = "regex-exclude"
-		optionNameExcludePrereleases = "exclude-prereleases"
-		optionNameExcludeUpdated     = "exclude-updated"
-		optionNameNote               = "note"
-		optionNameTag                = "tag"
-	)
+const (
+	optionNameEmail              = "email"
+	optionNameSlack              = "slack"
+	optionNameTelegram           = "telegram"
+	optionNameDiscord            = "discord"
+	optionNameHangoutsChat       = "hangouts-chat"
+	optionNameMicrosoftTeams     = "microsoft-teams"
+	optionNameMattermost         = "mattermost"
+	optionNameRocketchat         = "rocketchat"
+	optionNameMatrix             = "matrix"
+	optionNameWebhook            = "webhook"
+	optionNameExclusions         = "regex-exclude"
+	optionNameExcludePrereleases = "exclude-prereleases"
+	optionNameExcludeUpdated     = "exclude-updated"
+	optionNameNote               = "note"
+	optionNameTag                = "tag"
+)
 
+func (c *command) initProjectAddCmd(projectCmd *cobra.Command) (err error) {
 	cmd := &cobra.Command{
 		Use:   "add PROVIDER PROJECT_NAME",
 		Short: "Add a project to track",
@@ -42,96 +43,17 @@ func (c *command) initProjectAddCmd(projectCmd *cobra.Command) (err error) {
 				return cmd.Help()
 			}
 
-			o := &newreleases.ProjectOptions{}
-
-			flags := cmd.Flags()
-			email, err := flags.GetString(optionNameEmail)
-			if err != nil {
-				return err
-			}
-			if email != "" {
-				e := newreleases.EmailNotification(email)
-				o.EmailNotification = &e
-			}
-			o.SlackIDs, err = flags.GetStringArray(optionNameSlack)
-			if err != nil {
-				return err
-			}
-			o.TelegramChatIDs, err = flags.GetStringArray(optionNameTelegram)
-			if err != nil {
-				return err
-			}
-			o.DiscordIDs, err = flags.GetStringArray(optionNameDiscord)
-			if err != nil {
-				return err
-			}
-			o.HangoutsChatWebhookIDs, err = flags.GetStringArray(optionNameHangoutsChat)
+			o, err := newProjectOptionsFromFlags(cmd.Flags())
 			if err != nil {
 				return err
 			}
-			o.MSTeamsWebhookIDs, err = flags.GetStringArray(optionNameMicrosoftTeams)
-			if err != nil {
-				return err
-			}
-			o.MattermostWebhookIDs, err = flags.GetStringArray(optionNameMattermost)
-			if err != nil {
-				return err
-			}
-			o.RocketchatWebhookIDs, err = flags.GetStringArray(optionNameRocketchat)
-			if err != nil {
-				return err
-			}
-			o.MatrixRoomIDs, err = flags.GetStringArray(optionNameMatrix)
-			if err != nil {
-				return err
-			}
-			o.WebhookIDs, err = flags.GetStringArray(optionNameWebhook)
-			if err != nil {
-				return err
-			}
-			exclusions, err := flags.GetStringArray(optionNameExclusions)
-			if err != nil {
-				return err
-			}
-			for _, v := range exclusions {
-				var inverse bool
-				if strings.HasSuffix(v, "-inverse") {
-					inverse = true
-					v = strings.TrimSuffix(v, "-inverse")
-				}
-				o.Exclusions = append(o.Exclusions, newreleases.Exclusion{
-					Value:   v,
-					Inverse: inverse,
-				})
-			}
-			if flags.Changed(optionNameExcludePrereleases) {
-				excludePrereleases, err := flags.GetBool(optionNameExcludePrereleases)
-				if err != nil {
-					return err
-				}
-				o.ExcludePrereleases = &excludePrereleases
-			}
-			if flags.Changed(optionNameExcludeUpdated) {
-				excludeUpdated, err := flags.GetBool(optionNameExcludeUpdated)
-				if err != nil {
-					return err
-				}
-				o.ExcludeUpdated = &excludeUpdated
-			}
-			o.TagIDs, err = flags.GetStringArray(optionNameTag)
+
+			project, err := c.projectsService.Add(ctx, args[0], args[1], o)
 			if err != nil {
 				return err
 			}
-			if flags.Changed(optionNameNote) {
-				note, err := flags.GetString(optionNameNote)
-				if err != nil {
-					return err
-				}
-				o.Note = &note
-			}
 
-			project, err := c.projectsService.Add(ctx, args[0], args[1], o)
-			if err != nil {
+			if ok, err := c.writeOutput(cmd, project); ok || err != nil {
 				return err
 			}
 
@@ -146,22 +68,119 @@ func (c *command) initProjectAddCmd(projectCmd *cobra.Command) (err error) {
 		},
 	}
 
-	cmd.Flags().String(optionNameEmail, "none", "frequency of email notifications: instant, hourly, daily, weekly, none")
-	cmd.Flags().StringArray(optionNameSlack, nil, "Slack channel ID")
-	cmd.Flags().StringArray(optionNameTelegram, nil, "Telegram chat ID")
-	cmd.Flags().StringArray(optionNameDiscord, nil, "Discord channel ID")
-	cmd.Flags().StringArray(optionNameHangoutsChat, nil, "Hangouts Chat webhook ID")
-	cmd.Flags().StringArray(optionNameMicrosoftTeams, nil, "Microsoft Teams webhook ID")
-	cmd.Flags().StringArray(optionNameMattermost, nil, "Mattermost webhook ID")
-	cmd.Flags().StringArray(optionNameRocketchat, nil, "Rocket.Chat webhook ID")
-	cmd.Flags().StringArray(optionNameMatrix, nil, "Matrix room ID")
-	cmd.Flags().StringArray(optionNameWebhook, nil, "Webhook ID")
-	cmd.Flags().StringArray(optionNameExclusions, nil, "Regex version exclusion, suffix with \"-inverse\" for inclusion")
-	cmd.Flags().Bool(optionNameExcludePrereleases, false, "exclude pre-releases")
-	cmd.Flags().Bool(optionNameExcludeUpdated, false, "exclude updated")
-	cmd.Flags().StringArray(optionNameTag, nil, "Tag ID")
-	cmd.Flags().String(optionNameNote, "", "Note")
+	addProjectOptionsFlags(cmd.Flags())
 
 	projectCmd.AddCommand(cmd)
 	return addClientFlags(cmd)
 }
+
+// addProjectOptionsFlags adds flags for options of a newly added project.
+func addProjectOptionsFlags(flags *pflag.FlagSet) {
+	flags.String(optionNameEmail, "none", "frequency of email notifications: instant, hourly, daily, weekly, none")
+	flags.StringArray(optionNameSlack, nil, "Slack channel ID")
+	flags.StringArray(optionNameTelegram, nil, "Telegram chat ID")
+	flags.StringArray(optionNameDiscord, nil, "Discord channel ID")
+	flags.StringArray(optionNameHangoutsChat, nil, "Hangouts Chat webhook ID")
+	flags.StringArray(optionNameMicrosoftTeams, nil, "Microsoft Teams webhook ID")
+	flags.StringArray(optionNameMattermost, nil, "Mattermost webhook ID")
+	flags.StringArray(optionNameRocketchat, nil, "Rocket.Chat webhook ID")
+	flags.StringArray(optionNameMatrix, nil, "Matrix room ID")
+	flags.StringArray(optionNameWebhook, nil, "Webhook ID")
+	flags.StringArray(optionNameExclusions, nil, "Regex version exclusion, suffix with \"-inverse\" for inclusion")
+	flags.Bool(optionNameExcludePrereleases, false, "exclude pre-releases")
+	flags.Bool(optionNameExcludeUpdated, false, "exclude updated")
+	flags.StringArray(optionNameTag, nil, "Tag ID")
+	flags.String(optionNameNote, "", "Note")
+}
+
+// newProjectOptionsFromFlags returns project options from flags that are
+// added by addProjectOptionsFlags.
+func newProjectOptionsFromFlags(flags *pflag.FlagSet) (o *newreleases.ProjectOptions, err error) {
+	o = &newreleases.ProjectOptions{}
+
+	email, err := flags.GetString(optionNameEmail)
+	if err != nil {
+		return nil, err
+	}
+	if email != "" {
+		e := newreleases.EmailNotification(email)
+		o.EmailNotification = &e
+	}
+	o.SlackIDs, err = flags.GetStringArray(optionNameSlack)
+	if err != nil {
+		return nil, err
+	}
+	o.TelegramChatIDs, err = flags.GetStringArray(optionNameTelegram)
+	if err != nil {
+		return nil, err
+	}
+	o.DiscordIDs, err = flags.GetStringArray(optionNameDiscord)
+	if err != nil {
+		return nil, err
+	}
+	o.HangoutsChatWebhookIDs, err = flags.GetStringArray(optionNameHangoutsChat)
+	if err != nil {
+		return nil, err
+	}
+	o.MSTeamsWebhookIDs, err = flags.GetStringArray(optionNameMicrosoftTeams)
+	if err != nil {
+		return nil, err
+	}
+	o.MattermostWebhookIDs, err = flags.GetStringArray(optionNameMattermost)
+	if err != nil {
+		return nil, err
+	}
+	o.RocketchatWebhookIDs, err = flags.GetStringArray(optionNameRocketchat)
+	if err != nil {
+		return nil, err
+	}
+	o.MatrixRoomIDs, err = flags.GetStringArray(optionNameMatrix)
+	if err != nil {
+		return nil, err
+	}
+	o.WebhookIDs, err = flags.GetStringArray(optionNameWebhook)
+	if err != nil {
+		return nil, err
+	}
+	exclusions, err := flags.GetStringArray(optionNameExclusions)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range exclusions {
+		var inverse bool
+		if strings.HasSuffix(v, "-inverse") {
+			inverse = true
+			v = strings.TrimSuffix(v, "-inverse")
+		}
+		o.Exclusions = append(o.Exclusions, newreleases.Exclusion{
+			Value:   v,
+			Inverse: inverse,
+		})
+	}
+	if flags.Changed(optionNameExcludePrereleases) {
+		excludePrereleases, err := flags.GetBool(optionNameExcludePrereleases)
+		if err != nil {
+			return nil, err
+		}
+		o.ExcludePrereleases = &excludePrereleases
+	}
+	if flags.Changed(optionNameExcludeUpdated) {
+		excludeUpdated, err := flags.GetBool(optionNameExcludeUpdated)
+		if err != nil {
+			return nil, err
+		}
+		o.ExcludeUpdated = &excludeUpdated
+	}
+	o.TagIDs, err = flags.GetStringArray(optionNameTag)
+	if err != nil {
+		return nil, err
+	}
+	if flags.Changed(optionNameNote) {
+		note, err := flags.GetString(optionNameNote)
+		if err != nil {
+			return nil, err
+		}
+		o.Note = &note
+	}
+	return o, nil
+}
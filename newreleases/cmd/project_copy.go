@@ -0,0 +1,267 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"newreleases.io/newreleases"
+)
+
+func (c *command) initProjectCopyCmd(projectCmd *cobra.Command) (err error) {
+	var (
+		optionNameFromAuthKey = "from-auth-key"
+		optionNameFromProfile = "from-profile"
+		optionNameToProfile   = "to-profile"
+		optionNameProvider    = "provider"
+		optionNameTagID       = "tag"
+	)
+
+	var destination *account
+
+	cmd := &cobra.Command{
+		Use:   "copy",
+		Short: "Copy tracked projects from another account",
+		Long: `Copy tracked projects from another account to the configured account.
+
+The source account is specified by its auth key or by the profile from the
+config file. Projects can be also copied to the account of another profile.
+
+Tags of copied projects are added to the configured account if there are no
+tags with the same names. Notification targets are matched by their names:
+Slack team and channel, Telegram chat, Discord channel, Matrix room and
+webhook names. Targets that do not exist in the configured account are not
+set and are reported. Projects that are already tracked are not changed.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			flags := cmd.Flags()
+			provider, err := flags.GetString(optionNameProvider)
+			if err != nil {
+				return err
+			}
+			tagID, err := flags.GetString(optionNameTagID)
+			if err != nil {
+				return err
+			}
+
+			return c.copyProjects(cmd, c.sourceAccount, destination, newreleases.ProjectListOptions{
+				Provider: provider,
+				TagID:    tagID,
+			})
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := addClientConfigOptions(cmd, c.config); err != nil {
+				return err
+			}
+			flags := cmd.Flags()
+			fromAuthKey, err := flags.GetString(optionNameFromAuthKey)
+			if err != nil {
+				return err
+			}
+			fromProfile, err := flags.GetString(optionNameFromProfile)
+			if err != nil {
+				return err
+			}
+			toProfile, err := flags.GetString(optionNameToProfile)
+			if err != nil {
+				return err
+			}
+			if fromAuthKey != "" && fromProfile != "" {
+				return errors.New("only one of from-auth-key and from-profile options can be specified")
+			}
+
+			if toProfile != "" {
+				destination, err = c.profileAccount(cmd, toProfile)
+				if err != nil {
+					return fmt.Errorf("destination account: %w", err)
+				}
+			} else {
+				if err := c.setAccountServices(cmd, args); err != nil {
+					return err
+				}
+				destination = c.configuredAccount()
+			}
+
+			if c.sourceAccount != nil {
+				return nil
+			}
+			switch {
+			case fromProfile != "":
+				c.sourceAccount, err = c.profileAccount(cmd, fromProfile)
+				if err != nil {
+					return fmt.Errorf("source account: %w", err)
+				}
+			case fromAuthKey != "":
+				c.sourceAccount, err = c.newAccount(cmd, c.config, fromAuthKey)
+				if err != nil {
+					return err
+				}
+			default:
+				return errors.New("source account not specified")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(optionNameFromAuthKey, "", "auth key of the account to copy projects from")
+	cmd.Flags().String(optionNameFromProfile, "", "profile of the account to copy projects from")
+	cmd.Flags().String(optionNameToProfile, "", "profile of the account to copy projects to, the configured account if not specified")
+	cmd.Flags().String(optionNameProvider, "", "copy only projects from the provider")
+	cmd.Flags().String(optionNameTagID, "", "copy only projects with the tag ID from the source account")
+
+	projectCmd.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+// unmappedTarget is a notification target of a source project that does not
+// exist in the destination account.
+type unmappedTarget struct {
+	project      string
+	notification string
+	target       string
+}
+
+func (c *command) copyProjects(cmd *cobra.Command, src, dst *account, o newreleases.ProjectListOptions) (err error) {
+	ctx, cancel := newClientContext(c.config)
+	defer cancel()
+
+	projects, err := listAllProjects(ctx, src.projectsService, o)
+	if err != nil {
+		return err
+	}
+	if len(projects) == 0 {
+		cmd.Println("No projects found.")
+		return nil
+	}
+
+	srcTags, err := src.tagsService.List(ctx)
+	if err != nil {
+		return err
+	}
+	usedTagIDs := make(map[string]struct{})
+	for _, p := range projects {
+		for _, id := range p.TagIDs {
+			usedTagIDs[id] = struct{}{}
+		}
+	}
+	var tags []newreleases.Tag
+	for _, t := range srcTags {
+		if _, ok := usedTagIDs[t.ID]; ok {
+			tags = append(tags, t)
+		}
+	}
+	tagIDs, addedTags, err := dst.mapTagsByName(ctx, tags)
+	if err != nil {
+		return err
+	}
+
+	srcTargets, err := src.notificationTargetNames(ctx)
+	if err != nil {
+		return err
+	}
+	dstTargets, err := dst.notificationTargetNames(ctx)
+	if err != nil {
+		return err
+	}
+	dstTargetIDs := make(map[string]map[string]string, len(dstTargets))
+	for key, names := range dstTargets {
+		dstTargetIDs[key] = make(map[string]string, len(names))
+		for id, name := range names {
+			dstTargetIDs[key][name] = id
+		}
+	}
+
+	existing, err := listAllProjects(ctx, dst.projectsService, newreleases.ProjectListOptions{})
+	if err != nil {
+		return err
+	}
+	tracked := make(map[string]struct{}, len(existing))
+	for _, p := range existing {
+		tracked[p.Provider+"/"+p.Name] = struct{}{}
+	}
+
+	var copied int
+	var unmapped []unmappedTarget
+	for _, p := range projects {
+		if _, ok := tracked[p.Provider+"/"+p.Name]; ok {
+			continue
+		}
+		mp := newManifestProject(p)
+		mp.Tags = nil
+		for _, id := range p.TagIDs {
+			if newID, ok := tagIDs[id]; ok {
+				mp.Tags = append(mp.Tags, newID)
+			}
+		}
+		for _, t := range mp.notificationTargets() {
+			var ids []string
+			for _, id := range *t.ids {
+				name, ok := srcTargets[t.key][id]
+				if !ok {
+					unmapped = append(unmapped, unmappedTarget{project: p.Provider + " " + p.Name, notification: t.key, target: id})
+					continue
+				}
+				newID, ok := dstTargetIDs[t.key][name]
+				if !ok {
+					unmapped = append(unmapped, unmappedTarget{project: p.Provider + " " + p.Name, notification: t.key, target: name})
+					continue
+				}
+				ids = append(ids, newID)
+			}
+			*t.ids = ids
+		}
+		if err := c.addAccountProject(dst, mp); err != nil {
+			return fmt.Errorf("add project %s %s: %w", p.Provider, p.Name, err)
+		}
+		copied++
+	}
+
+	cmd.Printf("Tags: %v added, %v already existing.\n", addedTags, len(tags)-addedTags)
+	cmd.Printf("Projects: %v copied, %v already tracked.\n", copied, len(projects)-copied)
+
+	if len(unmapped) > 0 {
+		cmd.Println()
+		cmd.Println("Notification targets that are not found in the destination account:")
+		cmd.Println()
+		table := newTable(cmd.OutOrStdout())
+		table.SetHeader([]string{"Project", "Notification", "Target"})
+		for _, u := range unmapped {
+			table.Append([]string{u.project, u.notification, u.target})
+		}
+		table.Render()
+	}
+	return nil
+}
+
+func (c *command) addAccountProject(a *account, p manifestProject) (err error) {
+	ctx, cancel := newClientContext(c.config)
+	defer cancel()
+
+	_, err = a.projectsService.Add(ctx, p.Provider, p.Name, p.options())
+	return err
+}
+
+// notificationTarget references IDs of one kind of notification targets of
+// a manifest project.
+type notificationTarget struct {
+	key string
+	ids *[]string
+}
+
+func (p *manifestProject) notificationTargets() []notificationTarget {
+	return []notificationTarget{
+		{"slack", &p.Slack},
+		{"telegram", &p.Telegram},
+		{"discord", &p.Discord},
+		{"hangouts-chat", &p.HangoutsChat},
+		{"microsoft-teams", &p.MicrosoftTeams},
+		{"mattermost", &p.Mattermost},
+		{"rocketchat", &p.Rocketchat},
+		{"matrix", &p.Matrix},
+		{"webhook", &p.Webhook},
+	}
+}
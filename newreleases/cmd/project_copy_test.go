@@ -0,0 +1,241 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+func TestProjectCmd_Copy(t *testing.T) {
+	source := cmd.SourceAccount{
+		Projects: newMockProjectsService(1, nil, []newreleases.Project{
+			{ID: "mdsbe60td5gwgzetyksdfeyxt4", Name: "golang/go", Provider: "github", SlackIDs: []string{"s1"}, WebhookIDs: []string{"w1"}, TagIDs: []string{"33f1db7254b9"}},
+			{ID: "gwgzetyksdfeyxt4mdsbe60td5", Name: "vue", Provider: "npm", WebhookIDs: []string{"w2", "w3"}, TagIDs: []string{"1d33b7254b9f"}},
+			{ID: "ksdfeyxt4mdsbe60td5gwgzety", Name: "django", Provider: "pypi", TagIDs: []string{"33f1db7254b9"}},
+		}),
+		Tags:                   newMockTagsService(fullTags, nil),
+		SlackChannels:          newMockSlackChannelsService([]newreleases.SlackChannel{{ID: "s1", TeamName: "NewReleases", Channel: "general"}}, nil),
+		TelegramChats:          newMockTelegramChatssService(nil, nil),
+		DiscordChannels:        newMockDiscordChannelsService(nil, nil),
+		HangoutsChatWebhooks:   newMockHangoutsChatWebhooksService(nil, nil),
+		MicrosoftTeamsWebhooks: newMockMicrosoftTeamsWebhooksService(nil, nil),
+		MattermostWebhooks:     newMockMattermostWebhooksService(nil, nil),
+		RocketchatWebhooks:     newMockRocketchatWebhooksService(nil, nil),
+		MatrixRooms:            newMockMatrixRoomsService(nil, nil),
+		Webhooks:               newMockWebhooksService([]newreleases.Webhook{{ID: "w1", Name: "Builds"}, {ID: "w2", Name: "Deploys"}}, nil),
+	}
+
+	for _, tc := range []struct {
+		name       string
+		args       []string
+		wantOutput string
+		wantAdded  []string
+	}{
+		{
+			name:       "all",
+			wantOutput: "Tags: 1 added, 1 already existing.\nProjects: 2 copied, 1 already tracked.\n\nNotification targets that are not found in the destination account:\n\nPROJECT   NOTIFICATION   TARGET  \nnpm vue   webhook        Deploys   \nnpm vue   webhook        w3        \n",
+			wantAdded:  []string{"github golang/go slack=[ds1] webhook=[dw1] tags=[a8d9f7254b9f]", "npm vue slack=[] webhook=[] tags=[new]"},
+		},
+		{
+			name:       "provider",
+			args:       []string{"--provider", "github"},
+			wantOutput: "Tags: 0 added, 1 already existing.\nProjects: 1 copied, 0 already tracked.\n",
+			wantAdded:  []string{"github golang/go slack=[ds1] webhook=[dw1] tags=[a8d9f7254b9f]"},
+		},
+		{
+			name:       "tag",
+			args:       []string{"--tag", "33f1db7254b9"},
+			wantOutput: "Tags: 0 added, 1 already existing.\nProjects: 1 copied, 1 already tracked.\n",
+			wantAdded:  []string{"github golang/go slack=[ds1] webhook=[dw1] tags=[a8d9f7254b9f]"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			projectsService := &mockCopyProjectsService{
+				mockProjectsService: newMockProjectsService(1, nil, []newreleases.Project{
+					{ID: "xt4mdsbe60td5gwgzetyksdfey", Name: "django", Provider: "pypi"},
+				}),
+			}
+
+			var outputBuf bytes.Buffer
+			if err := newCommand(t,
+				cmd.WithArgs(append([]string{"project", "copy"}, tc.args...)...),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithSourceAccount(source),
+				cmd.WithProjectsService(projectsService),
+				cmd.WithTagsService(newMockTagsService([]newreleases.Tag{{ID: "a8d9f7254b9f", Name: "Cool"}}, nil)),
+				cmd.WithSlackChannelsService(newMockSlackChannelsService([]newreleases.SlackChannel{{ID: "ds1", TeamName: "NewReleases", Channel: "general"}}, nil)),
+				cmd.WithTelegramChatsService(newMockTelegramChatssService(nil, nil)),
+				cmd.WithDiscordChannelsService(newMockDiscordChannelsService(nil, nil)),
+				cmd.WithHangoutsChatWebhooksService(newMockHangoutsChatWebhooksService(nil, nil)),
+				cmd.WithMicrosoftTeamsWebhooksService(newMockMicrosoftTeamsWebhooksService(nil, nil)),
+				cmd.WithMattermostWebhooksService(newMockMattermostWebhooksService(nil, nil)),
+				cmd.WithRocketchatWebhooksService(newMockRocketchatWebhooksService(nil, nil)),
+				cmd.WithMatrixRoomsService(newMockMatrixRoomsService(nil, nil)),
+				cmd.WithWebhooksService(newMockWebhooksService([]newreleases.Webhook{{ID: "dw1", Name: "Builds"}}, nil)),
+			).Execute(); err != nil {
+				t.Fatal(err)
+			}
+
+			if gotOutput := outputBuf.String(); gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+			if !reflect.DeepEqual(projectsService.added, tc.wantAdded) {
+				t.Errorf("got added projects %q, want %q", projectsService.added, tc.wantAdded)
+			}
+		})
+	}
+}
+
+func TestProjectCmd_Copy_noSource(t *testing.T) {
+	err := newCommand(t,
+		cmd.WithArgs("project", "copy"),
+		cmd.WithProjectsService(newMockProjectsService(1, nil)),
+		cmd.WithTagsService(newMockTagsService(nil, nil)),
+		cmd.WithSlackChannelsService(newMockSlackChannelsService(nil, nil)),
+		cmd.WithTelegramChatsService(newMockTelegramChatssService(nil, nil)),
+		cmd.WithDiscordChannelsService(newMockDiscordChannelsService(nil, nil)),
+		cmd.WithHangoutsChatWebhooksService(newMockHangoutsChatWebhooksService(nil, nil)),
+		cmd.WithMicrosoftTeamsWebhooksService(newMockMicrosoftTeamsWebhooksService(nil, nil)),
+		cmd.WithMattermostWebhooksService(newMockMattermostWebhooksService(nil, nil)),
+		cmd.WithRocketchatWebhooksService(newMockRocketchatWebhooksService(nil, nil)),
+		cmd.WithMatrixRoomsService(newMockMatrixRoomsService(nil, nil)),
+		cmd.WithWebhooksService(newMockWebhooksService(nil, nil)),
+	).Execute()
+	if err == nil || err.Error() != "source account not specified" {
+		t.Fatalf("got error %v, want source account not specified", err)
+	}
+}
+
+func TestProjectCmd_Copy_profiles(t *testing.T) {
+	dir := t.TempDir()
+	config := "auth-key: z8jwn5ne0sg5a9b4qOpc9ty6an16rpymcw71\n" +
+		"profiles:\n" +
+		"    personal:\n" +
+		"        auth-key: 9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71\n" +
+		"    work:\n" +
+		"        auth-key: ne0sg5a9b4qOpc9ty6az8jwn5n16rpymcw71\n"
+	if err := os.WriteFile(filepath.Join(dir, ".newreleases.yaml"), []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	newAccount := func(projects cmd.ProjectsService) cmd.SourceAccount {
+		return cmd.SourceAccount{
+			Projects:               projects,
+			Tags:                   newMockTagsService(nil, nil),
+			SlackChannels:          newMockSlackChannelsService(nil, nil),
+			TelegramChats:          newMockTelegramChatssService(nil, nil),
+			DiscordChannels:        newMockDiscordChannelsService(nil, nil),
+			HangoutsChatWebhooks:   newMockHangoutsChatWebhooksService(nil, nil),
+			MicrosoftTeamsWebhooks: newMockMicrosoftTeamsWebhooksService(nil, nil),
+			MattermostWebhooks:     newMockMattermostWebhooksService(nil, nil),
+			RocketchatWebhooks:     newMockRocketchatWebhooksService(nil, nil),
+			MatrixRooms:            newMockMatrixRoomsService(nil, nil),
+			Webhooks:               newMockWebhooksService(nil, nil),
+		}
+	}
+
+	for _, tc := range []struct {
+		name       string
+		args       []string
+		wantOutput string
+		wantError  string
+		wantAdded  map[string][]string
+	}{
+		{
+			name:       "from profile to profile",
+			args:       []string{"--from-profile", "personal", "--to-profile", "work"},
+			wantOutput: "Tags: 0 added, 0 already existing.\nProjects: 1 copied, 0 already tracked.\n",
+			wantAdded:  map[string][]string{"work": {"github golang/go slack=[] webhook=[] tags=[]"}},
+		},
+		{
+			name:       "from profile to configured account",
+			args:       []string{"--from-profile", "work"},
+			wantOutput: "Tags: 0 added, 0 already existing.\nProjects: 1 copied, 0 already tracked.\n",
+			wantAdded:  map[string][]string{"default": {"npm vue slack=[] webhook=[] tags=[]"}},
+		},
+		{
+			name:      "missing profile",
+			args:      []string{"--from-profile", "personal", "--to-profile", "home"},
+			wantError: `destination account: profile "home" not found in the configuration`,
+		},
+		{
+			name:      "both sources",
+			args:      []string{"--from-profile", "personal", "--from-auth-key", "9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71"},
+			wantError: "only one of from-auth-key and from-profile options can be specified",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			projectsServices := map[string]*mockCopyProjectsService{
+				"default": {mockProjectsService: newMockProjectsService(1, nil)},
+				"personal": {mockProjectsService: newMockProjectsService(1, nil, []newreleases.Project{
+					{ID: "mdsbe60td5gwgzetyksdfeyxt4", Name: "golang/go", Provider: "github"},
+				})},
+				"work": {mockProjectsService: newMockProjectsService(1, nil, []newreleases.Project{
+					{ID: "gwgzetyksdfeyxt4mdsbe60td5", Name: "vue", Provider: "npm"},
+				})},
+			}
+
+			var outputBuf bytes.Buffer
+			err := newCommand(t,
+				cmd.WithHomeDir(dir),
+				cmd.WithArgs(append([]string{"project", "copy"}, tc.args...)...),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithAccounts(map[string]cmd.SourceAccount{
+					"9ty6an1z8jwn5ne0sg5a9b4qOpc6rpymcw71": newAccount(projectsServices["personal"]),
+					"ne0sg5a9b4qOpc9ty6az8jwn5n16rpymcw71": newAccount(projectsServices["work"]),
+				}),
+				cmd.WithProjectsService(projectsServices["default"]),
+				cmd.WithTagsService(newMockTagsService(nil, nil)),
+				cmd.WithSlackChannelsService(newMockSlackChannelsService(nil, nil)),
+				cmd.WithTelegramChatsService(newMockTelegramChatssService(nil, nil)),
+				cmd.WithDiscordChannelsService(newMockDiscordChannelsService(nil, nil)),
+				cmd.WithHangoutsChatWebhooksService(newMockHangoutsChatWebhooksService(nil, nil)),
+				cmd.WithMicrosoftTeamsWebhooksService(newMockMicrosoftTeamsWebhooksService(nil, nil)),
+				cmd.WithMattermostWebhooksService(newMockMattermostWebhooksService(nil, nil)),
+				cmd.WithRocketchatWebhooksService(newMockRocketchatWebhooksService(nil, nil)),
+				cmd.WithMatrixRoomsService(newMockMatrixRoomsService(nil, nil)),
+				cmd.WithWebhooksService(newMockWebhooksService(nil, nil)),
+			).Execute()
+			if tc.wantError != "" {
+				if err == nil || err.Error() != tc.wantError {
+					t.Fatalf("got error %v, want %v", err, tc.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if gotOutput := outputBuf.String(); gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+			for name, s := range projectsServices {
+				if !reflect.DeepEqual(s.added, tc.wantAdded[name]) {
+					t.Errorf("got added projects to %s account %q, want %q", name, s.added, tc.wantAdded[name])
+				}
+			}
+		})
+	}
+}
+
+type mockCopyProjectsService struct {
+	mockProjectsService
+	added []string
+}
+
+func (s *mockCopyProjectsService) Add(ctx context.Context, provider, name string, o *newreleases.ProjectOptions) (project *newreleases.Project, err error) {
+	s.added = append(s.added, fmt.Sprintf("%s %s slack=%v webhook=%v tags=%v", provider, name, o.SlackIDs, o.WebhookIDs, o.TagIDs))
+	return s.mockProjectsService.Add(ctx, provider, name, o)
+}
@@ -6,6 +6,8 @@
 package cmd
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
 	"newreleases.io/newreleases"
 )
@@ -42,6 +44,18 @@ func (c *command) initProjectListCmd(projectCmd *cobra.Command) (err error) {
 			if err != nil {
 				return err
 			}
+			all, err := flags.GetBool(optionNameAll)
+			if err != nil {
+				return err
+			}
+			limit, err := flags.GetInt(optionNameLimit)
+			if err != nil {
+				return err
+			}
+			concurrency, err := flags.GetInt(optionNameConcurrency)
+			if err != nil {
+				return err
+			}
 
 			o := newreleases.ProjectListOptions{
 				Page:     page,
@@ -52,11 +66,27 @@ func (c *command) initProjectListCmd(projectCmd *cobra.Command) (err error) {
 				o.Order = newreleases.ProjectListOrder(order)
 			}
 
-			projects, lastPage, err := c.projectsService.List(ctx, o)
+			var projects []newreleases.Project
+			var lastPage int
+			if all {
+				page = 1
+				projects, err = listAllPages(ctx, limit, concurrency, func(ctx context.Context, page int) ([]newreleases.Project, int, error) {
+					o := o
+					o.Page = page
+					return c.projectsService.List(ctx, o)
+				})
+			} else {
+				projects, lastPage, err = c.projectsService.List(ctx, o)
+				projects = limitElements(projects, limit)
+			}
 			if err != nil {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, projects); ok || err != nil {
+				return err
+			}
+
 			if len(projects) == 0 || err == newreleases.ErrNotFound {
 				if page <= 1 {
 					cmd.Println("No projects found.")
@@ -86,6 +116,9 @@ func (c *command) initProjectListCmd(projectCmd *cobra.Command) (err error) {
 	cmd.Flags().String(optionNameProvider, "", "filter by provider")
 	cmd.Flags().String(optionNameOrder, "", "sort projects: updated, added, name; default updated")
 	cmd.Flags().String(optionNameTagID, "", "filter by tag ID")
+	cmd.Flags().Bool(optionNameAll, false, "get projects from all pages")
+	cmd.Flags().Int(optionNameLimit, 0, "maximal number of projects to get, 0 for no limit")
+	cmd.Flags().Int(optionNameConcurrency, defaultConcurrency, "maximal number of pages to get concurrently with --all")
 
 	projectCmd.AddCommand(cmd)
 	return addClientFlags(cmd)
@@ -7,7 +7,13 @@ package cmd_test
 
 import (
 	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"newreleases.io/cmd/newreleases/cmd"
 	"newreleases.io/newreleases"
@@ -46,6 +52,44 @@ func TestProjectCmd_List(t *testing.T) {
 			),
 			wantOutput: "ID                           NAME                 PROVIDER \nmyxtdsbe60td5gwgzetyksdfe4   newreleases/cli-go   github     \n",
 		},
+		{
+			name: "all pages",
+			args: []string{"--all"},
+			projectsService: newMockProjectsService(2, nil,
+				[]newreleases.Project{
+					{ID: "mdsbe60td5gwgzetyksdfeyxt4", Name: "golang/go", Provider: "github"},
+				},
+				[]newreleases.Project{
+					{ID: "myxtdsbe60td5gwgzetyksdfe4", Name: "newreleases/cli-go", Provider: "github"},
+				},
+			),
+			wantOutput: "ID                           NAME                 PROVIDER \nmdsbe60td5gwgzetyksdfeyxt4   golang/go            github     \nmyxtdsbe60td5gwgzetyksdfe4   newreleases/cli-go   github     \n",
+		},
+		{
+			name: "all pages with limit",
+			args: []string{"--all", "--limit", "1"},
+			projectsService: newMockProjectsService(2, nil,
+				[]newreleases.Project{
+					{ID: "mdsbe60td5gwgzetyksdfeyxt4", Name: "golang/go", Provider: "github"},
+				},
+				[]newreleases.Project{
+					{ID: "myxtdsbe60td5gwgzetyksdfe4", Name: "newreleases/cli-go", Provider: "github"},
+				},
+			),
+			wantOutput: "ID                           NAME        PROVIDER \nmdsbe60td5gwgzetyksdfeyxt4   golang/go   github     \n",
+		},
+		{
+			name: "limit with more pages",
+			args: []string{"--limit", "1"},
+			projectsService: newMockProjectsService(2, nil,
+				[]newreleases.Project{
+					{ID: "mdsbe60td5gwgzetyksdfeyxt4", Name: "golang/go", Provider: "github"},
+					{ID: "myxtdsbe60td5gwgzetyksdfe4", Name: "newreleases/cli-go", Provider: "github"},
+				},
+				nil,
+			),
+			wantOutput: "ID                           NAME        PROVIDER \nmdsbe60td5gwgzetyksdfeyxt4   golang/go   github     \nMore projects on the next page...\n",
+		},
 		{
 			name: "projects filter by provider",
 			args: []string{"--provider", "github"},
@@ -115,3 +159,99 @@ func TestProjectCmd_List(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectCmd_List_allConcurrent(t *testing.T) {
+	var pages [][]newreleases.Project
+	for i := 0; i < 10; i++ {
+		pages = append(pages, []newreleases.Project{
+			{ID: fmt.Sprintf("id%02d", i), Name: fmt.Sprintf("project%02d", i), Provider: "github"},
+		})
+	}
+
+	for _, tc := range []struct {
+		name      string
+		args      []string
+		err       error
+		errPage   int
+		wantNames []string
+		wantError error
+	}{
+		{
+			name:      "all pages",
+			args:      []string{"--concurrency", "3"},
+			wantNames: []string{"project00", "project01", "project02", "project03", "project04", "project05", "project06", "project07", "project08", "project09"},
+		},
+		{
+			name:      "limit",
+			args:      []string{"--concurrency", "3", "--limit", "4"},
+			wantNames: []string{"project00", "project01", "project02", "project03"},
+		},
+		{
+			name:      "error",
+			args:      []string{"--concurrency", "3"},
+			err:       errTest,
+			errPage:   4,
+			wantError: errTest,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &mockSlowProjectsService{
+				mockProjectsService: newMockProjectsService(len(pages), nil, pages...),
+				err:                 tc.err,
+				errPage:             tc.errPage,
+			}
+
+			var outputBuf bytes.Buffer
+			if err := newCommand(t,
+				cmd.WithArgs(append([]string{"project", "list", "--all", "--template", "{{.Name}}"}, tc.args...)...),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithProjectsService(s),
+			).Execute(); err != tc.wantError {
+				t.Fatalf("got error %v, want %v", err, tc.wantError)
+			}
+
+			if tc.wantError != nil {
+				return
+			}
+
+			gotNames := strings.Fields(outputBuf.String())
+			if !reflect.DeepEqual(gotNames, tc.wantNames) {
+				t.Errorf("got names %v, want %v", gotNames, tc.wantNames)
+			}
+			if got := s.maxActive.Load(); got > 3 {
+				t.Errorf("got %v concurrent requests, want at most 3", got)
+			}
+		})
+	}
+}
+
+type mockSlowProjectsService struct {
+	mockProjectsService
+	err       error
+	errPage   int
+	active    atomic.Int32
+	maxActive atomic.Int32
+}
+
+func (s *mockSlowProjectsService) List(ctx context.Context, o newreleases.ProjectListOptions) (projects []newreleases.Project, lastPage int, err error) {
+	active := s.active.Add(1)
+	defer s.active.Add(-1)
+	for {
+		m := s.maxActive.Load()
+		if active <= m || s.maxActive.CompareAndSwap(m, active) {
+			break
+		}
+	}
+
+	if o.Page == s.errPage {
+		return nil, 0, s.err
+	}
+
+	// later pages respond sooner to check that the order is preserved
+	select {
+	case <-time.After(time.Duration(20-o.Page) * time.Millisecond):
+	case <-ctx.Done():
+		return nil, 0, ctx.Err()
+	}
+	return s.mockProjectsService.List(ctx, o)
+}
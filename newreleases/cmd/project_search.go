@@ -37,6 +37,10 @@ func (c *command) initProjectSearchCmd(projectCmd *cobra.Command) (err error) {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, projects); ok || err != nil {
+				return err
+			}
+
 			if len(projects) == 0 || err == newreleases.ErrNotFound {
 				cmd.Println("No projects found.")
 				return nil
@@ -271,8 +271,11 @@ func (c *command) initProjectUpdateCmd(projectCmd *cobra.Command) (err error) {
 			}
 
 			if project == nil || err == newreleases.ErrNotFound {
-				cmd.Println("Project not found.")
-				return nil
+				return c.writeNotFound(cmd, "Project not found.")
+			}
+
+			if ok, err := c.writeOutput(cmd, project); ok || err != nil {
+				return err
 			}
 
 			printProject(cmd, project)
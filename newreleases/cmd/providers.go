@@ -37,6 +37,10 @@ func (c *command) initProviderCmd() (err error) {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, providers); ok || err != nil {
+				return err
+			}
+
 			if len(providers) == 0 {
 				cmd.Println("No providers found.")
 				return nil
@@ -32,6 +32,12 @@ func (c *command) initReleaseCmd() (err error) {
 	if err := c.initReleaseNoteCmd(cmd); err != nil {
 		return err
 	}
+	if err := c.initReleaseCheckCmd(cmd); err != nil {
+		return err
+	}
+	if err := c.initReleaseWaitForCmd(cmd); err != nil {
+		return err
+	}
 
 	c.root.AddCommand(cmd)
 	return nil
@@ -47,25 +53,55 @@ func (c *command) initReleaseListCmd(releaseCmd *cobra.Command) (err error) {
 			ctx, cancel := newClientContext(c.config)
 			defer cancel()
 
-			page, err := cmd.Flags().GetInt(optionNamePage)
+			flags := cmd.Flags()
+			page, err := flags.GetInt(optionNamePage)
+			if err != nil {
+				return err
+			}
+			all, err := flags.GetBool(optionNameAll)
+			if err != nil {
+				return err
+			}
+			limit, err := flags.GetInt(optionNameLimit)
+			if err != nil {
+				return err
+			}
+			concurrency, err := flags.GetInt(optionNameConcurrency)
 			if err != nil {
 				return err
 			}
 
-			var releases []newreleases.Release
-			var lastPage int
+			var list pageLister[newreleases.Release]
 			switch len(args) {
 			case 1:
-				releases, lastPage, err = c.releasesService.ListByProjectID(ctx, args[0], page)
+				list = func(ctx context.Context, page int) ([]newreleases.Release, int, error) {
+					return c.releasesService.ListByProjectID(ctx, args[0], page)
+				}
 			case 2:
-				releases, lastPage, err = c.releasesService.ListByProjectName(ctx, args[0], args[1], page)
+				list = func(ctx context.Context, page int) ([]newreleases.Release, int, error) {
+					return c.releasesService.ListByProjectName(ctx, args[0], args[1], page)
+				}
 			default:
 				return cmd.Help()
 			}
+
+			var releases []newreleases.Release
+			var lastPage int
+			if all {
+				page = 1
+				releases, err = listAllPages(ctx, limit, concurrency, list)
+			} else {
+				releases, lastPage, err = list(ctx, page)
+				releases = limitElements(releases, limit)
+			}
 			if err != nil {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, releases); ok || err != nil {
+				return err
+			}
+
 			if len(releases) == 0 || err == newreleases.ErrNotFound {
 				if page <= 1 {
 					cmd.Println("No releases found.")
@@ -92,6 +128,9 @@ func (c *command) initReleaseListCmd(releaseCmd *cobra.Command) (err error) {
 	}
 
 	cmd.Flags().IntP(optionNamePage, "p", 1, "page number")
+	cmd.Flags().Bool(optionNameAll, false, "get releases from all pages")
+	cmd.Flags().Int(optionNameLimit, 0, "maximal number of releases to get, 0 for no limit")
+	cmd.Flags().Int(optionNameConcurrency, defaultConcurrency, "maximal number of pages to get concurrently with --all")
 
 	releaseCmd.AddCommand(cmd)
 	return addClientFlags(cmd)
@@ -119,8 +158,11 @@ func (c *command) initReleaseGetCmd(releaseCmd *cobra.Command) (err error) {
 			}
 
 			if release == nil {
-				cmd.Println("Release not found.")
-				return nil
+				return c.writeNotFound(cmd, "Release not found.")
+			}
+
+			if ok, err := c.writeOutput(cmd, release); ok || err != nil {
+				return err
 			}
 
 			printRelease(cmd, release)
@@ -160,8 +202,11 @@ func (c *command) initReleaseGetLatestCmd(releaseCmd *cobra.Command) (err error)
 			}
 
 			if release == nil {
-				cmd.Println("Release not found.")
-				return nil
+				return c.writeNotFound(cmd, "Release not found.")
+			}
+
+			if ok, err := c.writeOutput(cmd, release); ok || err != nil {
+				return err
 			}
 
 			printRelease(cmd, release)
@@ -201,8 +246,11 @@ func (c *command) initReleaseNoteCmd(releaseCmd *cobra.Command) (err error) {
 			}
 
 			if releaseNote == nil {
-				cmd.Println("Release note not found.")
-				return nil
+				return c.writeNotFound(cmd, "Release note not found.")
+			}
+
+			if ok, err := c.writeOutput(cmd, releaseNote); ok || err != nil {
+				return err
 			}
 
 			printReleaseNote(cmd, releaseNote)
@@ -221,15 +269,15 @@ func (c *command) initReleaseNoteCmd(releaseCmd *cobra.Command) (err error) {
 }
 
 func (c *command) setReleasesService(cmd *cobra.Command, args []string) (err error) {
-	if c.releasesService != nil {
-		return nil
-	}
-	client, err := c.getClient(cmd)
-	if err != nil {
-		return err
+	if c.releasesService == nil {
+		client, err := c.getClient(cmd)
+		if err != nil {
+			return err
+		}
+		c.releasesService = client.Releases
 	}
-	c.releasesService = client.Releases
-	return nil
+	c.releasesService, err = c.cachedReleasesService(cmd, c.releasesService)
+	return err
 }
 
 type releasesService interface {
@@ -0,0 +1,226 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"newreleases.io/newreleases"
+)
+
+const (
+	releaseCheckStatusUpToDate       = "up to date"
+	releaseCheckStatusUpdate         = "update available"
+	releaseCheckStatusSecurityUpdate = "security update available"
+)
+
+func (c *command) initReleaseCheckCmd(releaseCmd *cobra.Command) (err error) {
+	var (
+		optionNameCurrent    = "current"
+		optionNameConstraint = "constraint"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "check [PROVIDER PROJECT_NAME] | [PROJECT_ID] --current VERSION",
+		Short: "Check if there is a newer release than the current version",
+		Long: `Check if there is a newer release than the current version.
+
+Releases are compared with the current version by semantic versioning. Newer
+releases are the ones that are not excluded and that satisfy the constraint,
+if it is set with the --constraint flag. Pre-releases are newer releases only
+if the current version is also a pre-release.
+
+Constraints consist of comparators, like ">=1.21 <1.23", that are separated by
+spaces or commas and that all must be satisfied. Alternative comparators are
+separated by "||". Supported operators are =, !=, >, >=, <, <=, tilde ranges,
+like ~1.21.3, caret ranges, like ^1.21.3, and wildcards, like 1.21.x.
+
+The exit code is 0 if the current version is up to date, 2 if a newer release
+is available, 3 if any of newer releases lists CVEs and 1 on any error.`,
+		Annotations: map[string]string{
+			annotationNoCache: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			ctx, cancel := newClientContext(c.config)
+			defer cancel()
+
+			flags := cmd.Flags()
+			currentVersion, err := flags.GetString(optionNameCurrent)
+			if err != nil {
+				return err
+			}
+			current, ok := parseSemanticVersion(currentVersion)
+			if !ok {
+				return fmt.Errorf("invalid current version %q", currentVersion)
+			}
+			constraintValue, err := flags.GetString(optionNameConstraint)
+			if err != nil {
+				return err
+			}
+			var constraint versionConstraint
+			if constraintValue != "" {
+				constraint, err = parseVersionConstraint(constraintValue)
+				if err != nil {
+					return err
+				}
+			}
+
+			var list pageLister[newreleases.Release]
+			switch len(args) {
+			case 1:
+				list = func(ctx context.Context, page int) ([]newreleases.Release, int, error) {
+					return c.releasesService.ListByProjectID(ctx, args[0], page)
+				}
+			case 2:
+				list = func(ctx context.Context, page int) ([]newreleases.Release, int, error) {
+					return c.releasesService.ListByProjectName(ctx, args[0], args[1], page)
+				}
+			default:
+				return cmd.Help()
+			}
+
+			newer, currentRelease, err := listNewerReleases(ctx, list, current)
+			if err != nil {
+				return err
+			}
+
+			check := releaseCheck{
+				Current:           currentVersion,
+				CurrentPrerelease: current.isPrerelease(),
+				Updates:           make([]newreleases.Release, 0),
+			}
+			if currentRelease != nil {
+				check.CurrentFound = true
+				check.CurrentExcluded = currentRelease.IsExcluded
+				check.CurrentPrerelease = check.CurrentPrerelease || currentRelease.IsPrerelease
+			}
+
+			var latest semanticVersion
+			for _, r := range newer {
+				v, _ := parseSemanticVersion(r.Version)
+				if r.IsExcluded || ((r.IsPrerelease || v.isPrerelease()) && !check.CurrentPrerelease) {
+					continue
+				}
+				if constraint != nil && !constraint.match(v) {
+					continue
+				}
+				check.Updates = append(check.Updates, r)
+				for _, cve := range r.CVE {
+					if !slices.Contains(check.CVE, cve) {
+						check.CVE = append(check.CVE, cve)
+					}
+				}
+				if check.Latest == "" || v.compare(latest) > 0 {
+					check.Latest, latest = r.Version, v
+				}
+			}
+
+			var exitCode int
+			switch {
+			case len(check.CVE) > 0:
+				check.Status, exitCode = releaseCheckStatusSecurityUpdate, exitCodeSecurityUpdateAvailable
+			case len(check.Updates) > 0:
+				check.Status, exitCode = releaseCheckStatusUpdate, exitCodeUpdateAvailable
+			default:
+				check.Status = releaseCheckStatusUpToDate
+			}
+
+			if ok, err := c.writeOutput(cmd, check); err != nil {
+				return err
+			} else if !ok {
+				printReleaseCheck(cmd, check)
+			}
+
+			if exitCode != 0 {
+				return &ExitError{Code: exitCode}
+			}
+			return nil
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := addClientConfigOptions(cmd, c.config); err != nil {
+				return err
+			}
+			return c.setReleasesService(cmd, args)
+		},
+	}
+
+	cmd.Flags().String(optionNameCurrent, "", "current version")
+	cmd.Flags().String(optionNameConstraint, "", "version constraint that newer releases should satisfy")
+	if err := cmd.MarkFlagRequired(optionNameCurrent); err != nil {
+		return err
+	}
+
+	releaseCmd.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+// releaseCheck is the result of the release check command.
+type releaseCheck struct {
+	Current           string                `json:"current"`
+	CurrentFound      bool                  `json:"current_found"`
+	CurrentExcluded   bool                  `json:"current_excluded"`
+	CurrentPrerelease bool                  `json:"current_prerelease"`
+	Latest            string                `json:"latest,omitempty"`
+	Updates           []newreleases.Release `json:"updates"`
+	CVE               []string              `json:"cve,omitempty"`
+	Status            string                `json:"status"`
+}
+
+// listNewerReleases lists releases from the newest one until the release
+// with the current version is found, and returns the ones with versions
+// greater than the current version, together with the current release if it
+// is found. Releases with versions that are not semantic are ignored.
+func listNewerReleases(ctx context.Context, list pageLister[newreleases.Release], current semanticVersion) (newer []newreleases.Release, currentRelease *newreleases.Release, err error) {
+	for page := 1; ; page++ {
+		releases, lastPage, err := list(ctx, page)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, r := range releases {
+			v, ok := parseSemanticVersion(r.Version)
+			if !ok {
+				continue
+			}
+			switch v.compare(current) {
+			case 0:
+				return newer, &r, nil
+			case 1:
+				newer = append(newer, r)
+			}
+		}
+		if page >= lastPage {
+			return newer, nil, nil
+		}
+	}
+}
+
+func printReleaseCheck(cmd *cobra.Command, check releaseCheck) {
+	table := newTable(cmd.OutOrStdout())
+	table.Append([]string{"Current:", check.Current})
+	if !check.CurrentFound {
+		table.Append([]string{"Current Release:", "not found"})
+	}
+	if check.CurrentExcluded {
+		table.Append([]string{"Excluded:", "yes"})
+	}
+	if check.CurrentPrerelease {
+		table.Append([]string{"Pre-Release:", "yes"})
+	}
+	if check.Latest != "" {
+		table.Append([]string{"Latest:", check.Latest})
+		table.Append([]string{"Newer Releases:", strconv.Itoa(len(check.Updates))})
+	}
+	if len(check.CVE) > 0 {
+		table.Append([]string{"CVE:", strings.Join(check.CVE, ", ")})
+	}
+	table.Append([]string{"Status:", check.Status})
+	table.Render()
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+func TestReleaseCmd_Check(t *testing.T) {
+	releases := map[string][]newreleases.Release{
+		"github golang/go": {
+			{Version: "go1.23.0"},
+			{Version: "go1.23rc2", IsPrerelease: true},
+			{Version: "go1.22.2", CVE: []string{"CVE-2024-24787"}},
+			{Version: "go1.21.9", CVE: []string{"CVE-2024-24787"}},
+			{Version: "go1.22.1"},
+			{Version: "go1.21.8"},
+			{Version: "go1.22.0"},
+			{Version: "weekly.2024-01-01"},
+			{Version: "go1.21.7", IsExcluded: true},
+			{Version: "go1.21.6"},
+			{Version: "go1.20.14"},
+			{Version: "go1.21.5"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name         string
+		args         []string
+		wantOutput   string
+		wantExitCode int
+		wantError    string
+	}{
+		{
+			name: "security update",
+			args: []string{"github", "golang/go", "--current", "1.21.6", "--constraint", ">=1.21 <1.23"},
+			wantOutput: "Current:          1.21.6                      \n" +
+				"Latest:           go1.22.2                    \n" +
+				"Newer Releases:   5                           \n" +
+				"CVE:              CVE-2024-24787              \n" +
+				"Status:           security update available   \n",
+			wantExitCode: cmd.ExitCodeSecurityUpdateAvailable,
+		},
+		{
+			name: "update",
+			args: []string{"github", "golang/go", "--current", "1.22.2"},
+			wantOutput: "Current:          1.22.2             \n" +
+				"Latest:           go1.23.0           \n" +
+				"Newer Releases:   1                  \n" +
+				"Status:           update available   \n",
+			wantExitCode: cmd.ExitCodeUpdateAvailable,
+		},
+		{
+			name: "up to date within constraint",
+			args: []string{"github", "golang/go", "--current", "1.22.2", "--constraint", "~1.22.0"},
+			wantOutput: "Current:   1.22.2       \n" +
+				"Status:    up to date   \n",
+		},
+		{
+			name: "excluded current",
+			args: []string{"github", "golang/go", "--current", "v1.21.7", "--constraint", "1.21.x"},
+			wantOutput: "Current:          v1.21.7                     \n" +
+				"Excluded:         yes                         \n" +
+				"Latest:           go1.21.9                    \n" +
+				"Newer Releases:   2                           \n" +
+				"CVE:              CVE-2024-24787              \n" +
+				"Status:           security update available   \n",
+			wantExitCode: cmd.ExitCodeSecurityUpdateAvailable,
+		},
+		{
+			name: "pre-release current",
+			args: []string{"github", "golang/go", "--current", "1.23rc1", "--constraint", ">=1.23.0-0"},
+			wantOutput: "Current:           1.23rc1            \n" +
+				"Current Release:   not found          \n" +
+				"Pre-Release:       yes                \n" +
+				"Latest:            go1.23.0           \n" +
+				"Newer Releases:    2                  \n" +
+				"Status:            update available   \n",
+			wantExitCode: cmd.ExitCodeUpdateAvailable,
+		},
+		{
+			name:      "invalid current",
+			args:      []string{"github", "golang/go", "--current", "latest"},
+			wantError: `invalid current version "latest"`,
+		},
+		{
+			name:      "invalid constraint",
+			args:      []string{"github", "golang/go", "--current", "1.21.6", "--constraint", ">=1.21 <"},
+			wantError: `invalid version constraint "<"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var outputBuf bytes.Buffer
+			err := newCommand(t,
+				cmd.WithArgs(append([]string{"release", "check"}, tc.args...)...),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithReleasesService(newMockProjectReleasesService(releases)),
+			).Execute()
+			switch {
+			case tc.wantError != "":
+				if err == nil || err.Error() != tc.wantError {
+					t.Fatalf("got error %v, want %v", err, tc.wantError)
+				}
+				return
+			case tc.wantExitCode != 0:
+				var e *cmd.ExitError
+				if !errors.As(err, &e) || e.Code != tc.wantExitCode {
+					t.Fatalf("got error %v, want exit code %v", err, tc.wantExitCode)
+				}
+			case err != nil:
+				t.Fatal(err)
+			}
+
+			if gotOutput := outputBuf.String(); gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+		})
+	}
+}
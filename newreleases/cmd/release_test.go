@@ -64,6 +64,23 @@ func TestReleaseCmd_List(t *testing.T) {
 				)
 			},
 		},
+		{
+			name: "all pages with limit",
+			args: []string{"github", "golang/go", "--all", "--limit", "3"},
+			releasesService: newMockReleasesService([]newreleases.Release{
+				{Version: "v1.25.0", Date: newTime(t, "2019-10-22T01:45:55Z")},
+				{Version: "v1.21.6", Date: newTime(t, "2019-09-21T11:25:00Z")},
+			}, nil, 5, nil),
+			wantOutputFunc: func() string {
+				dateHeaderSep := strings.Repeat(" ", len(newTime(t, "2019-10-22T01:45:55Z").Local().String())-1)
+				return fmt.Sprintf("VERSION   DATE%sPRE-RELEASE   HAS NOTE   UPDATED   EXCLUDED   CVE \nv1.25.0   %s   no            no         no        no         no    \nv1.21.6   %s   no            no         no        no         no    \nv1.25.0   %s   no            no         no        no         no    \n",
+					dateHeaderSep,
+					newTime(t, "2019-10-22T01:45:55Z").Local(),
+					newTime(t, "2019-09-21T11:25:00Z").Local(),
+					newTime(t, "2019-10-22T01:45:55Z").Local(),
+				)
+			},
+		},
 		{
 			name:            "error",
 			args:            []string{"github", "golang/go"},
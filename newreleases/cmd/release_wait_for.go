@@ -0,0 +1,172 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"regexp"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"newreleases.io/newreleases"
+)
+
+func (c *command) initReleaseWaitForCmd(releaseCmd *cobra.Command) (err error) {
+	var (
+		optionNameVersionRegex = "version-regex"
+		optionNamePoll         = "poll"
+		optionNameDeadline     = "deadline"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "wait-for [PROVIDER PROJECT_NAME] | [PROJECT_ID] --version-regex REGEX",
+		Short: "Wait until a release with a matching version exists",
+		Long: `Wait until a release with a matching version exists.
+
+Releases are checked periodically until there is a non-excluded release with
+the version that matches the regular expression set with the --version-regex
+flag. The release is printed in the same way as with the get command. All
+releases are checked the first time, and only the latest ones afterwards.
+
+Errors of checks after the first one are printed and checks are continued.
+The deadline set with the --deadline flag also stops a check in progress.
+
+The exit code is 0 if the release is found, 124 if the deadline is reached,
+130 if the command is interrupted and 1 on any other error.`,
+		Annotations: map[string]string{
+			annotationNoCache: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			flags := cmd.Flags()
+			versionRegex, err := flags.GetString(optionNameVersionRegex)
+			if err != nil {
+				return err
+			}
+			re, err := regexp.Compile(versionRegex)
+			if err != nil {
+				return fmt.Errorf("version regex: %w", err)
+			}
+			poll, err := flags.GetDuration(optionNamePoll)
+			if err != nil {
+				return err
+			}
+			if poll <= 0 {
+				return errors.New("poll interval must be greater than zero")
+			}
+			deadline, err := flags.GetDuration(optionNameDeadline)
+			if err != nil {
+				return err
+			}
+
+			var list pageLister[newreleases.Release]
+			var project string
+			switch len(args) {
+			case 1:
+				project = args[0]
+				list = func(ctx context.Context, page int) ([]newreleases.Release, int, error) {
+					return c.releasesService.ListByProjectID(ctx, args[0], page)
+				}
+			case 2:
+				project = args[0] + " " + args[1]
+				list = func(ctx context.Context, page int) ([]newreleases.Release, int, error) {
+					return c.releasesService.ListByProjectName(ctx, args[0], args[1], page)
+				}
+			default:
+				return cmd.Help()
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			// Checks are made with the context that expires at the deadline,
+			// so that a slow check does not exceed it.
+			waitCtx := ctx
+			if deadline > 0 {
+				var cancel context.CancelFunc
+				waitCtx, cancel = context.WithDeadline(ctx, time.Now().Add(deadline))
+				defer cancel()
+			}
+			deadlineErr := &ExitError{Code: exitCodeTimeout, Err: fmt.Errorf("no release of %s matches %s after %s", project, versionRegex, deadline)}
+
+			cmd.PrintErrf("Waiting for a release of %s that matches %s.\n", project, versionRegex)
+
+			ticker := time.NewTicker(poll)
+			defer ticker.Stop()
+			for first := true; ; first = false {
+				release, err := c.findMatchingRelease(waitCtx, list, re, first)
+				switch {
+				case ctx.Err() != nil:
+					return &ExitError{Code: exitCodeInterrupted}
+				case errors.Is(waitCtx.Err(), context.DeadlineExceeded):
+					return deadlineErr
+				case err != nil && first:
+					return err
+				case err != nil:
+					cmd.PrintErrf("Error: %v\n", err)
+				case release != nil:
+					if ok, err := c.writeOutput(cmd, release); ok || err != nil {
+						return err
+					}
+					printRelease(cmd, release)
+					return nil
+				}
+
+				select {
+				case <-ticker.C:
+				case <-waitCtx.Done():
+					if ctx.Err() != nil {
+						return &ExitError{Code: exitCodeInterrupted}
+					}
+					return deadlineErr
+				}
+			}
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := addClientConfigOptions(cmd, c.config); err != nil {
+				return err
+			}
+			return c.setReleasesService(cmd, args)
+		},
+	}
+
+	cmd.Flags().String(optionNameVersionRegex, "", "regular expression that the release version should match")
+	cmd.Flags().Duration(optionNamePoll, 5*time.Minute, "time between checks")
+	cmd.Flags().Duration(optionNameDeadline, 0, "maximal time to wait, 0 for no limit")
+	if err := cmd.MarkFlagRequired(optionNameVersionRegex); err != nil {
+		return err
+	}
+
+	releaseCmd.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+// findMatchingRelease returns the newest non-excluded release with the version
+// that matches the regular expression, or nil if there is none. If all is
+// true, releases from all pages are checked, otherwise only from the first
+// page.
+func (c *command) findMatchingRelease(ctx context.Context, list pageLister[newreleases.Release], re *regexp.Regexp, all bool) (release *newreleases.Release, err error) {
+	ctx, cancel := context.WithTimeout(ctx, c.config.GetDuration(optionNameTimeout))
+	defer cancel()
+
+	for page := 1; ; page++ {
+		releases, lastPage, err := list(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range releases {
+			if !r.IsExcluded && re.MatchString(r.Version) {
+				return &r, nil
+			}
+		}
+		if !all || page >= lastPage {
+			return nil, nil
+		}
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+func TestReleaseCmd_WaitFor(t *testing.T) {
+	releases := map[string][]newreleases.Release{
+		"github kubernetes/kubernetes": {
+			{Version: "v1.30.1"},
+			{Version: "v1.29.5", IsExcluded: true},
+			{Version: "v1.30.0"},
+			{Version: "v1.28.10"},
+			{Version: "v1.29.4"},
+		},
+	}
+
+	for _, tc := range []struct {
+		name         string
+		args         []string
+		wantOutput   string
+		wantExitCode int
+		wantError    string
+	}{
+		{
+			name: "found on a later page",
+			args: []string{"github", "kubernetes/kubernetes", "--version-regex", `^v1\.29\.`},
+			wantOutput: "Version:   v1.29.4                         \n" +
+				"Date:      0001-01-01 00:00:00 +0000 UTC   \n",
+		},
+		{
+			name:       "json",
+			args:       []string{"github", "kubernetes/kubernetes", "--version-regex", `^v1\.28\.`, "--output", "json"},
+			wantOutput: "{\n  \"version\": \"v1.28.10\",\n  \"date\": \"0001-01-01T00:00:00Z\"\n}\n",
+		},
+		{
+			name:         "deadline",
+			args:         []string{"github", "kubernetes/kubernetes", "--version-regex", `^v1\.31\.`, "--poll", "1ms", "--deadline", "20ms"},
+			wantExitCode: cmd.ExitCodeTimeout,
+		},
+		{
+			name:      "invalid regex",
+			args:      []string{"github", "kubernetes/kubernetes", "--version-regex", `^v1\.(`},
+			wantError: "version regex: error parsing regexp: missing closing ): `^v1\\.(`",
+		},
+		{
+			name:      "project not found",
+			args:      []string{"github", "kubernetes/minikube", "--version-regex", `^v1\.`},
+			wantError: "not found",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var outputBuf, errorOutputBuf bytes.Buffer
+			err := newCommand(t,
+				cmd.WithArgs(append([]string{"release", "wait-for"}, tc.args...)...),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithErrorOutput(&errorOutputBuf),
+				cmd.WithReleasesService(newMockProjectReleasesService(releases)),
+			).Execute()
+			switch {
+			case tc.wantError != "":
+				if err == nil || err.Error() != tc.wantError {
+					t.Fatalf("got error %v, want %v", err, tc.wantError)
+				}
+				return
+			case tc.wantExitCode != 0:
+				var e *cmd.ExitError
+				if !errors.As(err, &e) || e.Code != tc.wantExitCode {
+					t.Fatalf("got error %v, want exit code %v", err, tc.wantExitCode)
+				}
+			case err != nil:
+				t.Fatal(err)
+			}
+
+			if gotOutput := outputBuf.String(); gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+		})
+	}
+
+	t.Run("deadline during the first check", func(t *testing.T) {
+		start := time.Now()
+		err := newCommand(t,
+			cmd.WithArgs("release", "wait-for", "github", "kubernetes/kubernetes", "--version-regex", `^v1\.31\.`, "--deadline", "20ms"),
+			cmd.WithOutput(new(bytes.Buffer)),
+			cmd.WithErrorOutput(new(bytes.Buffer)),
+			cmd.WithReleasesService(mockBlockingReleasesService{}),
+		).Execute()
+		var e *cmd.ExitError
+		if !errors.As(err, &e) || e.Code != cmd.ExitCodeTimeout {
+			t.Fatalf("got error %v, want exit code %v", err, cmd.ExitCodeTimeout)
+		}
+		if d := time.Since(start); d > 5*time.Second {
+			t.Errorf("command returned after %v", d)
+		}
+	})
+
+	t.Run("published while waiting", func(t *testing.T) {
+		s := &mockPublishingReleasesService{
+			mockProjectReleasesService: newMockProjectReleasesService(releases),
+			publish:                    newreleases.Release{Version: "v1.31.0"},
+			after:                      3,
+		}
+
+		var outputBuf, errorOutputBuf bytes.Buffer
+		if err := newCommand(t,
+			cmd.WithArgs("release", "wait-for", "github", "kubernetes/kubernetes", "--version-regex", `^v1\.31\.`, "--poll", "1ms"),
+			cmd.WithOutput(&outputBuf),
+			cmd.WithErrorOutput(&errorOutputBuf),
+			cmd.WithReleasesService(s),
+		).Execute(); err != nil {
+			t.Fatal(err)
+		}
+
+		wantOutput := "Version:   v1.31.0                         \n" +
+			"Date:      0001-01-01 00:00:00 +0000 UTC   \n"
+		if gotOutput := outputBuf.String(); gotOutput != wantOutput {
+			t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+		}
+		wantErrorOutput := "Waiting for a release of github kubernetes/kubernetes that matches ^v1\\.31\\..\n"
+		if gotErrorOutput := errorOutputBuf.String(); gotErrorOutput != wantErrorOutput {
+			t.Errorf("got error output %q, want %q", gotErrorOutput, wantErrorOutput)
+		}
+	})
+}
+
+// mockPublishingReleasesService prepends the publish release to the list of
+// releases after the first page has been requested the after number of times.
+type mockPublishingReleasesService struct {
+	mockProjectReleasesService
+	publish newreleases.Release
+	after   int
+
+	mu    sync.Mutex
+	count int
+}
+
+func (s *mockPublishingReleasesService) ListByProjectName(ctx context.Context, provider, projectName string, page int) (releases []newreleases.Release, lastPage int, err error) {
+	releases, lastPage, err = s.mockProjectReleasesService.ListByProjectName(ctx, provider, projectName, page)
+	if err != nil || page != 1 {
+		return releases, lastPage, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.count++
+	if s.count > s.after {
+		releases = append([]newreleases.Release{s.publish}, releases...)
+	}
+	return releases, lastPage, nil
+}
+
+// mockBlockingReleasesService blocks listing releases until the context is
+// done.
+type mockBlockingReleasesService struct {
+	mockReleasesService
+}
+
+func (mockBlockingReleasesService) ListByProjectName(ctx context.Context, provider, projectName string, page int) (releases []newreleases.Release, lastPage int, err error) {
+	<-ctx.Done()
+	return nil, 0, ctx.Err()
+}
@@ -0,0 +1,215 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"newreleases.io/newreleases"
+)
+
+const (
+	optionNameRetries      = "retries"
+	optionNameRetryMaxWait = "retry-max-wait"
+	optionNameVerbose      = "verbose"
+)
+
+// retryPolicy defines how many times and how long after a failed request it
+// is retried.
+type retryPolicy struct {
+	retries int
+	minWait time.Duration
+	maxWait time.Duration
+	log     io.Writer
+}
+
+// newRetryPolicy returns the retry policy that is configured by command flags.
+func newRetryPolicy(cmd *cobra.Command) (p *retryPolicy, err error) {
+	flags := cmd.Flags()
+	p = &retryPolicy{
+		minWait: time.Second,
+		log:     io.Discard,
+	}
+	if flags.Lookup(optionNameRetries) == nil {
+		return p, nil
+	}
+	p.retries, err = flags.GetInt(optionNameRetries)
+	if err != nil {
+		return nil, err
+	}
+	p.maxWait, err = flags.GetDuration(optionNameRetryMaxWait)
+	if err != nil {
+		return nil, err
+	}
+	verbose, err := flags.GetBool(optionNameVerbose)
+	if err != nil {
+		return nil, err
+	}
+	if verbose {
+		p.log = cmd.ErrOrStderr()
+	}
+	return p, nil
+}
+
+// backoff returns the exponentially increasing wait duration before the retry
+// of the attempt, with added jitter, but not longer than maxWait.
+func (p *retryPolicy) backoff(attempt int) time.Duration {
+	d := p.minWait << min(attempt, 16)
+	d = d/2 + rand.N(d/2+1)
+	if d > p.maxWait {
+		d = p.maxWait
+	}
+	return d
+}
+
+// wait blocks for the duration or until the context is done.
+func (p *retryPolicy) wait(ctx context.Context, d time.Duration) (err error) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retryTransport retries GET and HEAD requests that failed because of
+// a network error, rate limiting or a server error. Other requests are not
+// retried, as it can not be known if they were processed, but transient
+// failures are reported to the requestResult from the request context.
+type retryTransport struct {
+	base   http.RoundTripper
+	policy *retryPolicy
+}
+
+func (t *retryTransport) RoundTrip(r *http.Request) (resp *http.Response, err error) {
+	ctx := r.Context()
+	idempotent := r.Method == http.MethodGet || r.Method == http.MethodHead
+	for attempt := 0; ; attempt++ {
+		resp, err = t.base.RoundTrip(r)
+		if !isTransientFailure(ctx, resp, err) {
+			return resp, err
+		}
+		if result, ok := ctx.Value(requestResultKey{}).(*requestResult); ok {
+			result.transient = true
+		}
+		if !idempotent || attempt >= t.policy.retries {
+			return resp, err
+		}
+
+		d := t.policy.backoff(attempt)
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+			if ra, ok := retryAfter(resp.Header, time.Now()); ok {
+				if ra > t.policy.maxWait {
+					return resp, nil
+				}
+				d = ra
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+		fmt.Fprintf(t.policy.log, "Retrying %s %s in %s (%v/%v): %s\n", r.Method, r.URL.Path, d, attempt+1, t.policy.retries, reason)
+		if err := t.policy.wait(ctx, d); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isTransientFailure returns true if the request failed in a way that it may
+// succeed if it is sent again.
+func isTransientFailure(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil
+	}
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode == http.StatusNotImplemented, resp.StatusCode == http.StatusHTTPVersionNotSupported:
+		return false
+	}
+	return resp.StatusCode >= 500
+}
+
+// retryAfter returns the duration to wait before the next request from the
+// Retry-After header, in seconds or as an HTTP date, or from the
+// X-Ratelimit-Reset header, as a Unix time.
+func retryAfter(h http.Header, now time.Time) (d time.Duration, ok bool) {
+	if v := h.Get("Retry-After"); v != "" {
+		if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+			return time.Duration(s) * time.Second, true
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return max(t.Sub(now), 0), true
+		}
+	}
+	if v := h.Get("X-Ratelimit-Reset"); v != "" {
+		if s, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return max(time.Unix(s, 0).Sub(now), 0), true
+		}
+	}
+	return 0, false
+}
+
+// requestResult is set in the request context to find out if a request that
+// is not retried by the retryTransport failed in a transient way.
+type requestResult struct {
+	transient bool
+}
+
+type requestResultKey struct{}
+
+// retryingProjectsService retries adding projects if the request failed in
+// a transient way, but only after it is confirmed that the project was not
+// added by the failed request. All other methods are called directly, as
+// their requests are retried by the retryTransport, if they are idempotent.
+type retryingProjectsService struct {
+	projectsService
+	policy *retryPolicy
+}
+
+func newRetryingProjectsService(s projectsService, p *retryPolicy) projectsService {
+	if p.retries <= 0 {
+		return s
+	}
+	return retryingProjectsService{projectsService: s, policy: p}
+}
+
+func (s retryingProjectsService) Add(ctx context.Context, provider, name string, o *newreleases.ProjectOptions) (project *newreleases.Project, err error) {
+	for attempt := 0; ; attempt++ {
+		result := new(requestResult)
+		project, err = s.projectsService.Add(context.WithValue(ctx, requestResultKey{}, result), provider, name, o)
+		if err == nil || !result.transient || attempt >= s.policy.retries {
+			return project, err
+		}
+
+		d := s.policy.backoff(attempt)
+		fmt.Fprintf(s.policy.log, "Retrying to add %s %s in %s (%v/%v): %v\n", provider, name, d, attempt+1, s.policy.retries, err)
+		if err := s.policy.wait(ctx, d); err != nil {
+			return nil, err
+		}
+
+		p, getErr := s.projectsService.GetByName(ctx, provider, name)
+		if getErr == nil && p != nil {
+			fmt.Fprintf(s.policy.log, "Project %s %s was added.\n", provider, name)
+			return p, nil
+		}
+		if getErr != nil && getErr != newreleases.ErrNotFound {
+			return nil, err
+		}
+	}
+}
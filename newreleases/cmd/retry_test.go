@@ -0,0 +1,240 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+var durationRe = regexp.MustCompile(`in [0-9.]+[mµn]?s`)
+
+func TestRetryTransport(t *testing.T) {
+	for _, tc := range []struct {
+		name         string
+		method       string
+		statuses     []int
+		retryAfter   string
+		wantStatus   int
+		wantRequests int
+		wantLog      string
+	}{
+		{
+			name:         "success",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantRequests: 1,
+		},
+		{
+			name:         "server errors",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			wantStatus:   http.StatusOK,
+			wantRequests: 3,
+			wantLog: "Retrying GET /v1/projects in DURATION (1/3): 503 Service Unavailable\n" +
+				"Retrying GET /v1/projects in DURATION (2/3): 502 Bad Gateway\n",
+		},
+		{
+			name:         "retries exhausted",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusInternalServerError},
+			wantStatus:   http.StatusInternalServerError,
+			wantRequests: 4,
+		},
+		{
+			name:         "rate limited",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:   "0",
+			wantStatus:   http.StatusOK,
+			wantRequests: 2,
+			wantLog:      "Retrying GET /v1/projects in DURATION (1/3): 429 Too Many Requests\n",
+		},
+		{
+			name:         "rate limited longer than max wait",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:   "120",
+			wantStatus:   http.StatusTooManyRequests,
+			wantRequests: 1,
+		},
+		{
+			name:         "client error",
+			method:       http.MethodGet,
+			statuses:     []int{http.StatusNotFound, http.StatusOK},
+			wantStatus:   http.StatusNotFound,
+			wantRequests: 1,
+		},
+		{
+			name:         "not idempotent",
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusServiceUnavailable, http.StatusOK},
+			wantStatus:   http.StatusServiceUnavailable,
+			wantRequests: 1,
+		},
+		{
+			name:         "not idempotent rate limited",
+			method:       http.MethodPost,
+			statuses:     []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:   "0",
+			wantStatus:   http.StatusTooManyRequests,
+			wantRequests: 1,
+		},
+		{
+			name:         "delete",
+			method:       http.MethodDelete,
+			statuses:     []int{http.StatusBadGateway, http.StatusOK},
+			wantStatus:   http.StatusBadGateway,
+			wantRequests: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var requests int
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := tc.statuses[min(requests, len(tc.statuses)-1)]
+				requests++
+				if tc.retryAfter != "" {
+					w.Header().Set("Retry-After", tc.retryAfter)
+				}
+				w.WriteHeader(status)
+			}))
+			defer server.Close()
+
+			var logBuf bytes.Buffer
+			client := &http.Client{Transport: cmd.NewRetryTransport(http.DefaultTransport, 3, time.Minute, &logBuf)}
+			req, err := http.NewRequest(tc.method, server.URL+"/v1/projects", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.wantStatus {
+				t.Errorf("got status %v, want %v", resp.StatusCode, tc.wantStatus)
+			}
+			if requests != tc.wantRequests {
+				t.Errorf("got %v requests, want %v", requests, tc.wantRequests)
+			}
+			if tc.wantLog != "" {
+				// backoff durations have random jitter
+				if gotLog := durationRe.ReplaceAllString(logBuf.String(), "in DURATION"); gotLog != tc.wantLog {
+					t.Errorf("got log %q, want %q", gotLog, tc.wantLog)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryingProjectsService_Add(t *testing.T) {
+	errUnavailable := errors.New("service unavailable")
+
+	for _, tc := range []struct {
+		name        string
+		failures    int
+		transient   bool
+		added       bool
+		wantAdds    int
+		wantError   error
+		wantProject string
+	}{
+		{
+			name:        "success",
+			wantAdds:    1,
+			wantProject: "new",
+		},
+		{
+			name:        "transient failure",
+			failures:    2,
+			transient:   true,
+			wantAdds:    3,
+			wantProject: "new",
+		},
+		{
+			name:        "transient failure of added project",
+			failures:    1,
+			transient:   true,
+			added:       true,
+			wantAdds:    1,
+			wantProject: "existing",
+		},
+		{
+			name:      "retries exhausted",
+			failures:  5,
+			transient: true,
+			wantAdds:  4,
+			wantError: errUnavailable,
+		},
+		{
+			name:      "other failure",
+			failures:  1,
+			wantAdds:  1,
+			wantError: errUnavailable,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &mockFailingProjectsService{
+				failures:  tc.failures,
+				transient: tc.transient,
+				added:     tc.added,
+				err:       errUnavailable,
+			}
+			var logBuf bytes.Buffer
+			p, err := cmd.NewRetryingProjectsService(s, 3, &logBuf).Add(context.Background(), "github", "golang/go", nil)
+			if !errors.Is(err, tc.wantError) {
+				t.Fatalf("got error %v, want %v", err, tc.wantError)
+			}
+			if s.adds != tc.wantAdds {
+				t.Errorf("got %v adds, want %v", s.adds, tc.wantAdds)
+			}
+			if tc.wantProject != "" && (p == nil || p.ID != tc.wantProject) {
+				t.Errorf("got project %+v, want ID %q", p, tc.wantProject)
+			}
+		})
+	}
+}
+
+// mockFailingProjectsService fails to add a project the failures number of
+// times and reports if failures were transient. If added is true, the project
+// is found by name after the first failure, as if it was added.
+type mockFailingProjectsService struct {
+	mockProjectsService
+	failures  int
+	transient bool
+	added     bool
+	err       error
+	adds      int
+}
+
+func (s *mockFailingProjectsService) Add(ctx context.Context, provider, name string, o *newreleases.ProjectOptions) (*newreleases.Project, error) {
+	s.adds++
+	if s.adds <= s.failures {
+		if s.transient {
+			cmd.MarkTransientFailure(ctx)
+		}
+		return nil, s.err
+	}
+	return &newreleases.Project{ID: "new", Provider: provider, Name: name}, nil
+}
+
+func (s *mockFailingProjectsService) GetByName(ctx context.Context, provider, name string) (*newreleases.Project, error) {
+	if s.added && s.adds > 0 {
+		return &newreleases.Project{ID: "existing", Provider: provider, Name: name}, nil
+	}
+	return nil, newreleases.ErrNotFound
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// semanticVersion is a version with numeric release components and optional
+// pre-release identifiers, like 1.2.3-rc.1.
+type semanticVersion struct {
+	numbers    []uint64
+	prerelease []string
+}
+
+// parseSemanticVersion parses versions that loosely follow semantic
+// versioning. Versions may have any number of numeric components and a
+// prefix of letters, like v1.2 or go1.22.0. Pre-release identifiers may also
+// directly follow numbers, like in 1.23rc1. Build metadata is ignored.
+func parseSemanticVersion(s string) (v semanticVersion, ok bool) {
+	s = strings.TrimLeftFunc(s, func(r rune) bool {
+		return unicode.IsLetter(r) || r == '-' || r == '_'
+	})
+	s, _, _ = strings.Cut(s, "+")
+	s, prerelease, hasPrerelease := strings.Cut(s, "-")
+	if i := strings.IndexFunc(s, unicode.IsLetter); !hasPrerelease && i > 0 && s[i-1] != '.' {
+		s, prerelease, hasPrerelease = s[:i], s[i:], true
+	}
+	if s == "" || (hasPrerelease && prerelease == "") {
+		return semanticVersion{}, false
+	}
+	for _, e := range strings.Split(s, ".") {
+		n, err := strconv.ParseUint(e, 10, 64)
+		if err != nil {
+			return semanticVersion{}, false
+		}
+		v.numbers = append(v.numbers, n)
+	}
+	if hasPrerelease {
+		v.prerelease = strings.Split(prerelease, ".")
+	}
+	return v, true
+}
+
+// isPrerelease returns true if the version has pre-release identifiers.
+func (v semanticVersion) isPrerelease() bool {
+	return len(v.prerelease) > 0
+}
+
+// compare returns -1, 0 or 1 if the version is lower than, equal to or
+// greater than the other version. Missing numeric components are compared as
+// zeros and pre-release versions are lower than the release with the same
+// numbers.
+func (v semanticVersion) compare(o semanticVersion) int {
+	for i := 0; i < max(len(v.numbers), len(o.numbers)); i++ {
+		var a, b uint64
+		if i < len(v.numbers) {
+			a = v.numbers[i]
+		}
+		if i < len(o.numbers) {
+			b = o.numbers[i]
+		}
+		if a != b {
+			return cmp.Compare(a, b)
+		}
+	}
+
+	switch {
+	case !v.isPrerelease() && !o.isPrerelease():
+		return 0
+	case !v.isPrerelease():
+		return 1
+	case !o.isPrerelease():
+		return -1
+	}
+	for i := 0; i < min(len(v.prerelease), len(o.prerelease)); i++ {
+		if r := comparePrereleaseIdentifiers(v.prerelease[i], o.prerelease[i]); r != 0 {
+			return r
+		}
+	}
+	return cmp.Compare(len(v.prerelease), len(o.prerelease))
+}
+
+// comparePrereleaseIdentifiers compares numeric identifiers numerically and
+// other identifiers lexically, where numeric identifiers are lower.
+func comparePrereleaseIdentifiers(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return cmp.Compare(an, bn)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// versionConstraint is a set of alternative comparator lists, where a version
+// satisfies the constraint if it satisfies all comparators of any list.
+type versionConstraint [][]versionComparator
+
+type versionComparator struct {
+	op      string
+	version semanticVersion
+}
+
+var versionComparatorRegex = regexp.MustCompile(`^(>=|<=|!=|==|>|<|=|~|\^)?\s*(\S+)$`)
+
+// parseVersionConstraint parses constraints with comparators separated by
+// spaces or commas, like ">=1.21 <1.23", and alternatives separated by "||".
+// Tilde and caret ranges, like ~1.21.3 and ^1.21, and wildcards, like 1.21.x,
+// are supported.
+func parseVersionConstraint(s string) (c versionConstraint, err error) {
+	for _, alternative := range strings.Split(s, "||") {
+		// Allow spaces between operators and versions, like ">= 1.21".
+		fields := strings.Fields(strings.ReplaceAll(alternative, ",", " "))
+		var comparators []versionComparator
+		for i := 0; i < len(fields); i++ {
+			field := fields[i]
+			if strings.Trim(field, "<>=!~^") == "" && i+1 < len(fields) {
+				i++
+				field += fields[i]
+			}
+			m := versionComparatorRegex.FindStringSubmatch(field)
+			if m == nil || strings.Trim(field, "<>=!~^") == "" {
+				return nil, fmt.Errorf("invalid version constraint %q", field)
+			}
+			cs, err := newVersionComparators(m[1], m[2])
+			if err != nil {
+				return nil, err
+			}
+			comparators = append(comparators, cs...)
+		}
+		if len(comparators) == 0 {
+			return nil, fmt.Errorf("invalid version constraint %q", s)
+		}
+		c = append(c, comparators)
+	}
+	return c, nil
+}
+
+// newVersionComparators returns comparators for an operator and a version,
+// expanding ranges to lower and upper bounds.
+func newVersionComparators(op, version string) (cs []versionComparator, err error) {
+	var wildcard bool
+	for _, suffix := range []string{".x", ".X", ".*"} {
+		if v, ok := strings.CutSuffix(version, suffix); ok {
+			version, wildcard = v, true
+			break
+		}
+	}
+	v, ok := parseSemanticVersion(version)
+	if !ok {
+		return nil, fmt.Errorf("invalid version %q in constraint", version)
+	}
+	if wildcard {
+		if op != "" && op != "=" && op != "==" {
+			return nil, fmt.Errorf("invalid version constraint %q", op+version+".x")
+		}
+		// Increment the last number.
+		return rangeComparators(v, len(v.numbers)-1), nil
+	}
+
+	switch op {
+	case "~":
+		// Increment the minor number, or the major if it is the only one.
+		return rangeComparators(v, min(1, len(v.numbers)-1)), nil
+	case "^":
+		// Increment the first non-zero number.
+		i := 0
+		for i < len(v.numbers)-1 && v.numbers[i] == 0 {
+			i++
+		}
+		return rangeComparators(v, i), nil
+	case "":
+		op = "="
+	case "==":
+		op = "="
+	}
+	return []versionComparator{{op: op, version: v}}, nil
+}
+
+// rangeComparators returns comparators for versions that are greater than or
+// equal to the version and lower than the version with the number at index i
+// incremented.
+func rangeComparators(v semanticVersion, i int) []versionComparator {
+	upper := semanticVersion{numbers: append(append([]uint64(nil), v.numbers[:i]...), v.numbers[i]+1)}
+	// The lowest pre-release, so that pre-releases of the upper bound are
+	// not in the range.
+	upper.prerelease = []string{"0"}
+	return []versionComparator{
+		{op: ">=", version: semanticVersion{numbers: v.numbers, prerelease: v.prerelease}},
+		{op: "<", version: upper},
+	}
+}
+
+// match returns true if the version satisfies the constraint.
+func (c versionConstraint) match(v semanticVersion) bool {
+	for _, comparators := range c {
+		ok := true
+		for _, comparator := range comparators {
+			if !comparator.match(v) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (c versionComparator) match(v semanticVersion) bool {
+	r := v.compare(c.version)
+	switch c.op {
+	case ">":
+		return r > 0
+	case ">=":
+		return r >= 0
+	case "<":
+		return r < 0
+	case "<=":
+		return r <= 0
+	case "!=":
+		return r != 0
+	}
+	return r == 0
+}
@@ -0,0 +1,105 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+)
+
+func TestCompareVersions(t *testing.T) {
+	for _, tc := range []struct {
+		a, b   string
+		want   int
+		wantOK bool
+	}{
+		{a: "1.2.3", b: "1.2.3", want: 0, wantOK: true},
+		{a: "v1.2.3", b: "1.2.3", want: 0, wantOK: true},
+		{a: "go1.22.0", b: "v1.22", want: 0, wantOK: true},
+		{a: "1.2.3", b: "1.2.4", want: -1, wantOK: true},
+		{a: "1.10.0", b: "1.9.0", want: 1, wantOK: true},
+		{a: "2", b: "1.99.99", want: 1, wantOK: true},
+		{a: "1.2.3+build.1", b: "1.2.3", want: 0, wantOK: true},
+		{a: "1.2.3-rc.1", b: "1.2.3", want: -1, wantOK: true},
+		{a: "1.2.3-rc.2", b: "1.2.3-rc.10", want: -1, wantOK: true},
+		{a: "1.2.3-beta", b: "1.2.3-alpha", want: 1, wantOK: true},
+		{a: "1.2.3-1", b: "1.2.3-alpha", want: -1, wantOK: true},
+		{a: "1.2.3-alpha", b: "1.2.3-alpha.1", want: -1, wantOK: true},
+		{a: "go1.23rc2", b: "1.23.0-rc1", want: 1, wantOK: true},
+		{a: "go1.23rc2", b: "go1.23.0", want: -1, wantOK: true},
+		{a: "latest", b: "1.2.3"},
+		{a: "1.2.x", b: "1.2.3"},
+		{a: "1.2.3-", b: "1.2.3"},
+	} {
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			got, ok := cmd.CompareVersions(tc.a, tc.b)
+			if ok != tc.wantOK {
+				t.Fatalf("got ok %v, want %v", ok, tc.wantOK)
+			}
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestMatchVersionConstraint(t *testing.T) {
+	for _, tc := range []struct {
+		constraint string
+		version    string
+		want       bool
+		wantError  bool
+	}{
+		{constraint: ">=1.21 <1.23", version: "1.21.0", want: true},
+		{constraint: ">=1.21 <1.23", version: "1.22.9", want: true},
+		{constraint: ">=1.21 <1.23", version: "1.23.0"},
+		{constraint: ">=1.21 <1.23", version: "1.20.14"},
+		{constraint: ">= 1.21, < 1.23", version: "v1.22.1", want: true},
+		{constraint: "1.21.3", version: "1.21.3", want: true},
+		{constraint: "=1.21", version: "1.21.0", want: true},
+		{constraint: "!=1.21.3", version: "1.21.3"},
+		{constraint: ">1.21.3", version: "1.21.4", want: true},
+		{constraint: "<=1.21.3", version: "1.21.4"},
+		{constraint: "~1.21.3", version: "1.21.9", want: true},
+		{constraint: "~1.21.3", version: "1.22.0"},
+		{constraint: "~1.21.3", version: "1.21.2"},
+		{constraint: "~1.21", version: "1.21.9", want: true},
+		{constraint: "~1", version: "1.99.0", want: true},
+		{constraint: "^1.21.3", version: "1.99.0", want: true},
+		{constraint: "^1.21.3", version: "2.0.0"},
+		{constraint: "^1.21.3", version: "2.0.0-rc.1"},
+		{constraint: "^0.2.3", version: "0.2.9", want: true},
+		{constraint: "^0.2.3", version: "0.3.0"},
+		{constraint: "^0.0.3", version: "0.0.4"},
+		{constraint: "1.21.x", version: "1.21.12", want: true},
+		{constraint: "1.21.x", version: "1.22.0"},
+		{constraint: "1.*", version: "1.22.0", want: true},
+		{constraint: "<1.20 || >=1.22", version: "1.19.1", want: true},
+		{constraint: "<1.20 || >=1.22", version: "1.21.1"},
+		{constraint: "<1.20 || >=1.22", version: "1.22.0", want: true},
+		{constraint: ">=latest", version: "1.0.0", wantError: true},
+		{constraint: "", version: "1.0.0", wantError: true},
+		{constraint: "1.0 ||", version: "1.0.0", wantError: true},
+		{constraint: ">1.x", version: "1.0.0", wantError: true},
+	} {
+		t.Run(tc.constraint+" "+tc.version, func(t *testing.T) {
+			got, err := cmd.MatchVersionConstraint(tc.constraint, tc.version)
+			if tc.wantError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -25,6 +25,10 @@ func (c *command) initSlackCmd() (err error) {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, elements); ok || err != nil {
+				return err
+			}
+
 			if len(elements) == 0 {
 				cmd.Println("No Slack Channels found.")
 				return nil
@@ -38,16 +38,16 @@ func (c *command) initTagCmd() (err error) {
 	return nil
 }
 
-func (c *command) setTagsService(cmd *cobra.Command, args []string) error {
-	if c.tagsService != nil {
-		return nil
+func (c *command) setTagsService(cmd *cobra.Command, args []string) (err error) {
+	if c.tagsService == nil {
+		client, err := c.getClient(cmd)
+		if err != nil {
+			return err
+		}
+		c.tagsService = client.Tags
 	}
-	client, err := c.getClient(cmd)
-	if err != nil {
-		return err
-	}
-	c.tagsService = client.Tags
-	return nil
+	c.tagsService, err = c.cachedTagsService(cmd, c.tagsService)
+	return err
 }
 
 type tagsService interface {
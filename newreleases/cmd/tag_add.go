@@ -26,6 +26,10 @@ func (c *command) initTagAddCmd(tagCmd *cobra.Command) (err error) {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, tag); ok || err != nil {
+				return err
+			}
+
 			printTag(cmd, tag)
 			return nil
 		},
@@ -27,15 +27,17 @@ func (c *command) initTagGetCmd(tagCmd *cobra.Command) (err error) {
 			tag, err := c.tagsService.Get(ctx, args[0])
 			if err != nil {
 				if errors.Is(err, newreleases.ErrNotFound) {
-					cmd.Println("Tag not found.")
-					return nil
+					return c.writeNotFound(cmd, "Tag not found.")
 				}
 				return err
 			}
 
 			if tag == nil {
-				cmd.Println("Tag not found.")
-				return nil
+				return c.writeNotFound(cmd, "Tag not found.")
+			}
+
+			if ok, err := c.writeOutput(cmd, tag); ok || err != nil {
+				return err
 			}
 
 			printTag(cmd, tag)
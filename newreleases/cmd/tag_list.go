@@ -22,6 +22,10 @@ func (c *command) initTagListCmd(tagCmd *cobra.Command) (err error) {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, tags); ok || err != nil {
+				return err
+			}
+
 			if len(tags) == 0 {
 				cmd.Println("No tags found.")
 				return nil
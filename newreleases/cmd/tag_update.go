@@ -42,12 +42,15 @@ func (c *command) initTagUpdateCmd(tagCmd *cobra.Command) (err error) {
 			tag, err := c.tagsService.Update(ctx, args[0], name)
 			if err != nil {
 				if errors.Is(err, newreleases.ErrNotFound) {
-					cmd.Println("Tag not found.")
-					return nil
+					return c.writeNotFound(cmd, "Tag not found.")
 				}
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, tag); ok || err != nil {
+				return err
+			}
+
 			printTag(cmd, tag)
 			return nil
 		},
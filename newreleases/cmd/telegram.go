@@ -25,6 +25,10 @@ func (c *command) initTelegramCmd() (err error) {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, chats); ok || err != nil {
+				return err
+			}
+
 			if len(chats) == 0 {
 				cmd.Println("No Telegram Chats found.")
 				return nil
@@ -0,0 +1,92 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	optionNameTemplate     = "template"
+	optionNameTemplateFile = "template-file"
+)
+
+var templateFuncs = template.FuncMap{
+	"join": func(sep string, elems []string) string {
+		return strings.Join(elems, sep)
+	},
+	"date": func(layout string, t time.Time) string {
+		return t.Local().Format(layout)
+	},
+	"yesNo": yesNo,
+	"truncate": func(n int, s string) string {
+		if r := []rune(s); len(r) > n {
+			return string(r[:n])
+		}
+		return s
+	},
+	"upper": strings.ToUpper,
+}
+
+// initTemplate parses the template provided with the template or
+// template-file command line flags. A template can not be combined with an
+// explicitly requested json or yaml output format.
+func (c *command) initTemplate() (err error) {
+	if c.templateText != "" && c.templateFile != "" {
+		return errors.New("only one of template and template-file options can be specified")
+	}
+	if (c.templateText != "" || c.templateFile != "") && c.root.PersistentFlags().Changed(optionNameOutput) && c.output != outputFormatTable {
+		return fmt.Errorf("template options can not be used with %s output format", c.output)
+	}
+	switch {
+	case c.templateText != "":
+		c.template, err = template.New(optionNameTemplate).Funcs(templateFuncs).Parse(c.templateText)
+	case c.templateFile != "":
+		var data []byte
+		data, err = os.ReadFile(c.templateFile)
+		if err != nil {
+			return err
+		}
+		c.template, err = template.New(filepath.Base(c.templateFile)).Funcs(templateFuncs).Parse(string(data))
+	}
+	return err
+}
+
+// writeTemplate executes the template for v, or for every element of v if it
+// is a slice, terminating each result with a new line.
+func writeTemplate(w io.Writer, t *template.Template, v interface{}) (err error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return executeTemplate(w, t, v)
+	}
+	for i := 0; i < rv.Len(); i++ {
+		if err := executeTemplate(w, t, rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func executeTemplate(w io.Writer, t *template.Template, v interface{}) (err error) {
+	var b strings.Builder
+	if err := t.Execute(&b, v); err != nil {
+		return err
+	}
+	s := b.String()
+	if !strings.HasSuffix(s, "\n") {
+		s += "\n"
+	}
+	_, err = io.WriteString(w, s)
+	return err
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+func TestTemplate(t *testing.T) {
+	templateFile := filepath.Join(t.TempDir(), "tmpl")
+	if err := os.WriteFile(templateFile, []byte("{{.Provider}}/{{.Name}}\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name            string
+		args            []string
+		projectsService cmd.ProjectsService
+		releasesService cmd.ReleasesService
+		wantOutput      string
+		wantError       bool
+	}{
+		{
+			name:            "project list",
+			args:            []string{"project", "list", "--template", "{{.Name}} {{.Provider}}"},
+			projectsService: newMockProjectsService(1, nil, []newreleases.Project{minimalProject, fullProject}),
+			wantOutput:      "golang/go github\ngolang/go github\n",
+		},
+		{
+			name:            "project list no projects",
+			args:            []string{"project", "list", "--template", "{{.Name}}"},
+			projectsService: newMockProjectsService(1, nil),
+			wantOutput:      "",
+		},
+		{
+			name:            "project get functions",
+			args:            []string{"project", "get", "github", "golang/go", "--template", `{{upper .Provider}} {{join ", " .TagIDs}} {{truncate 7 .Note}} {{yesNo .ExcludeUpdated}}`},
+			projectsService: newMockProjectsService(1, nil, []newreleases.Project{fullProject}),
+			wantOutput:      "GITHUB 33f1db7254b9 Initial yes\n",
+		},
+		{
+			name: "release list date",
+			args: []string{"release", "list", "github", "golang/go", "--template", `{{.Version}} {{date "2006" .Date}}`},
+			releasesService: newMockReleasesService([]newreleases.Release{
+				{Version: "v1.25.0", Date: newTime(t, "2019-10-22T01:45:55Z")},
+				{Version: "v1.21.6", Date: newTime(t, "2018-09-21T11:25:00Z")},
+			}, nil, 1, nil),
+			wantOutput: "v1.25.0 2019\nv1.21.6 2018\n",
+		},
+		{
+			name:            "template file",
+			args:            []string{"project", "list", "--template-file", templateFile},
+			projectsService: newMockProjectsService(1, nil, []newreleases.Project{minimalProject}),
+			wantOutput:      "github/golang/go\n",
+		},
+		{
+			name:            "template and template file",
+			args:            []string{"project", "list", "--template", "{{.Name}}", "--template-file", templateFile},
+			projectsService: newMockProjectsService(1, nil),
+			wantError:       true,
+		},
+		{
+			name:            "invalid template",
+			args:            []string{"project", "list", "--template", "{{.Name"},
+			projectsService: newMockProjectsService(1, nil),
+			wantError:       true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var outputBuf bytes.Buffer
+			err := newCommand(t,
+				cmd.WithArgs(tc.args...),
+				cmd.WithOutput(&outputBuf),
+				cmd.WithProjectsService(tc.projectsService),
+				cmd.WithReleasesService(tc.releasesService),
+			).Execute()
+			if tc.wantError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if gotOutput := outputBuf.String(); gotOutput != tc.wantOutput {
+				t.Errorf("got output %q, want %q", gotOutput, tc.wantOutput)
+			}
+		})
+	}
+}
+
+func TestTemplate_outputFormat(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		args      []string
+		wantError string
+	}{
+		{
+			name:      "json",
+			args:      []string{"project", "list", "--template", "{{.Name}}", "-o", "json"},
+			wantError: "template options can not be used with json output format",
+		},
+		{
+			name:      "yaml",
+			args:      []string{"project", "list", "--template-file", "tmpl", "--output", "yaml"},
+			wantError: "template options can not be used with yaml output format",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := newCommand(t,
+				cmd.WithArgs(tc.args...),
+				cmd.WithProjectsService(newMockProjectsService(1, nil)),
+			).Execute()
+			if err == nil || err.Error() != tc.wantError {
+				t.Fatalf("got error %v, want %v", err, tc.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"newreleases.io/newreleases"
+)
+
+func (c *command) initWatchCmd() (err error) {
+	var (
+		optionNameExec     = "exec"
+		optionNameInterval = "interval"
+		optionNameState    = "state"
+		optionNameOnce     = "once"
+		optionNameProvider = "provider"
+		optionNameTagID    = "tag"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Run a command when tracked projects have new releases",
+		Long: `Run a command when tracked projects have new releases.
+
+The latest releases of tracked projects, or only of the ones from a provider
+or with a tag, are checked periodically. When a project has a new latest
+release, the command set with the --exec flag is run by the shell with the
+release information in environment variables:
+
+  NR_PROJECT_ID   project ID
+  NR_PROVIDER     project provider
+  NR_PROJECT      project name
+  NR_VERSION      release version
+  NR_DATE         release date in RFC 3339 format
+  NR_PRERELEASE   "true" if the release is a pre-release, otherwise "false"
+  NR_CVE          comma separated CVE identifiers listed in the release
+
+The last seen version of every project is saved in the state file, so that
+the command is not run again for the same releases after a restart. Versions
+of projects that are not in the state file, like on the first run, are only
+saved without running the command. If the command fails, the version is not
+saved and the command is run again on the next check. Errors of listing
+projects are printed and the projects are listed again on the next check.`,
+		Annotations: map[string]string{
+			annotationNoCache: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			flags := cmd.Flags()
+			command, err := flags.GetString(optionNameExec)
+			if err != nil {
+				return err
+			}
+			interval, err := flags.GetDuration(optionNameInterval)
+			if err != nil {
+				return err
+			}
+			if interval <= 0 {
+				return errors.New("interval must be greater than zero")
+			}
+			stateFile, err := flags.GetString(optionNameState)
+			if err != nil {
+				return err
+			}
+			if stateFile == "" {
+				if err := c.setHomeDir(); err != nil {
+					return err
+				}
+				stateFile = filepath.Join(c.homeDir, ".newreleases-watch.json")
+			}
+			once, err := flags.GetBool(optionNameOnce)
+			if err != nil {
+				return err
+			}
+			provider, err := flags.GetString(optionNameProvider)
+			if err != nil {
+				return err
+			}
+			tagID, err := flags.GetString(optionNameTagID)
+			if err != nil {
+				return err
+			}
+
+			state, err := readWatchState(stateFile)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			o := newreleases.ProjectListOptions{
+				Provider: provider,
+				TagID:    tagID,
+			}
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				if err := c.watchReleases(ctx, cmd, state, o, command); err != nil {
+					if once {
+						return err
+					}
+					// Projects are listed again on the next check, so that a
+					// temporary failure does not stop watching.
+					cmd.PrintErrf("Error: %v\n", err)
+				}
+				if err := state.write(stateFile); err != nil {
+					return err
+				}
+				if once {
+					return nil
+				}
+				select {
+				case <-ticker.C:
+				case <-ctx.Done():
+					return nil
+				}
+			}
+		},
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if err := addClientConfigOptions(cmd, c.config); err != nil {
+				return err
+			}
+			if err := c.setProjectsService(cmd, args); err != nil {
+				return err
+			}
+			return c.setReleasesService(cmd, args)
+		},
+	}
+
+	cmd.Flags().String(optionNameExec, "", "shell command to run for every new release")
+	cmd.Flags().Duration(optionNameInterval, 5*time.Minute, "time between checks for new releases")
+	cmd.Flags().String(optionNameState, "", "file with last seen versions (default is $HOME/.newreleases-watch.json)")
+	cmd.Flags().Bool(optionNameOnce, false, "check for new releases only once and exit")
+	cmd.Flags().String(optionNameProvider, "", "watch only projects from the provider")
+	cmd.Flags().String(optionNameTagID, "", "watch only projects with the tag ID")
+	if err := cmd.MarkFlagRequired(optionNameExec); err != nil {
+		return err
+	}
+
+	c.root.AddCommand(cmd)
+	return addClientFlags(cmd)
+}
+
+// watchState holds the last seen versions by project IDs.
+type watchState struct {
+	Versions map[string]string `json:"versions"`
+}
+
+func readWatchState(filename string) (s *watchState, err error) {
+	s = &watchState{Versions: make(map[string]string)}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return s, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("state file %s: %w", filename, err)
+	}
+	if s.Versions == nil {
+		s.Versions = make(map[string]string)
+	}
+	return s, nil
+}
+
+// write saves the state to a temporary file which replaces the existing one,
+// so that the state is not lost if the program is interrupted.
+func (s *watchState) write(filename string) (err error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := filename + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filename)
+}
+
+// watchReleases checks the latest releases of projects once and runs the
+// command for every new release. Errors of getting releases and of running
+// the command are printed, while other projects are still checked. An error
+// is returned only if projects can not be listed.
+func (c *command) watchReleases(ctx context.Context, cmd *cobra.Command, state *watchState, o newreleases.ProjectListOptions, command string) (err error) {
+	listCtx, cancel := newClientContext(c.config)
+	defer cancel()
+
+	projects, err := listAllProjects(listCtx, c.projectsService, o)
+	if err != nil {
+		return fmt.Errorf("list projects: %w", err)
+	}
+
+	for _, p := range projects {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		release, err := c.latestRelease(p.ID)
+		if err != nil {
+			cmd.PrintErrf("Error: %s %s: %v\n", p.Provider, p.Name, err)
+			continue
+		}
+		if release == nil {
+			continue
+		}
+
+		last, ok := state.Versions[p.ID]
+		if !ok {
+			state.Versions[p.ID] = release.Version
+			continue
+		}
+		if last == release.Version {
+			continue
+		}
+
+		cmd.Printf("%s %s %s\n", p.Provider, p.Name, release.Version)
+		if err := runWatchCommand(ctx, cmd, command, p, release); err != nil {
+			cmd.PrintErrf("Error: %s %s %s: %v\n", p.Provider, p.Name, release.Version, err)
+			continue
+		}
+		state.Versions[p.ID] = release.Version
+	}
+	return nil
+}
+
+func (c *command) latestRelease(projectID string) (release *newreleases.Release, err error) {
+	ctx, cancel := newClientContext(c.config)
+	defer cancel()
+
+	release, err = c.releasesService.GetLatestByProjectID(ctx, projectID)
+	if err == newreleases.ErrNotFound {
+		return nil, nil
+	}
+	return release, err
+}
+
+// runWatchCommand runs the command by the shell with the release information
+// in environment variables.
+func runWatchCommand(ctx context.Context, cmd *cobra.Command, command string, p newreleases.Project, r *newreleases.Release) (err error) {
+	e := shellCommand(ctx, command)
+	e.Env = append(os.Environ(),
+		"NR_PROJECT_ID="+p.ID,
+		"NR_PROVIDER="+p.Provider,
+		"NR_PROJECT="+p.Name,
+		"NR_VERSION="+r.Version,
+		"NR_DATE="+r.Date.Format(time.RFC3339),
+		"NR_PRERELEASE="+strconv.FormatBool(r.IsPrerelease),
+		"NR_CVE="+strings.Join(r.CVE, ","),
+	)
+	e.Stdout = cmd.OutOrStdout()
+	e.Stderr = cmd.ErrOrStderr()
+	return e.Run()
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/newreleases"
+)
+
+func TestWatchCmd(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook commands are run by sh")
+	}
+
+	dir := t.TempDir()
+	stateFile := filepath.Join(dir, "state.json")
+	hookOutput := filepath.Join(dir, "hook.txt")
+
+	projectsService := newMockProjectsService(1, nil, []newreleases.Project{
+		{ID: "p1", Provider: "github", Name: "golang/go"},
+		{ID: "p2", Provider: "npm", Name: "vue"},
+		{ID: "p3", Provider: "pypi", Name: "django"},
+	})
+
+	run := func(t *testing.T, releases map[string]newreleases.Release, hook string, args ...string) (output, errorOutput string) {
+		t.Helper()
+
+		var outputBuf, errorOutputBuf bytes.Buffer
+		if err := newCommand(t,
+			cmd.WithArgs(append([]string{"watch", "--once", "--state", stateFile, "--exec", hook}, args...)...),
+			cmd.WithOutput(&outputBuf),
+			cmd.WithErrorOutput(&errorOutputBuf),
+			cmd.WithProjectsService(projectsService),
+			cmd.WithReleasesService(newMockLatestReleasesService(releases)),
+		).Execute(); err != nil {
+			t.Fatal(err)
+		}
+		return outputBuf.String(), errorOutputBuf.String()
+	}
+
+	readFile := func(t *testing.T, filename string) string {
+		t.Helper()
+
+		data, err := os.ReadFile(filename)
+		if err != nil && !os.IsNotExist(err) {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	hook := `echo "$NR_PROJECT_ID $NR_PROVIDER $NR_PROJECT $NR_VERSION $NR_PRERELEASE $NR_CVE" >> ` + hookOutput
+
+	t.Run("first check", func(t *testing.T) {
+		output, _ := run(t, map[string]newreleases.Release{
+			"p1": {Version: "go1.22.0"},
+			"p2": {Version: "3.4.0"},
+		}, hook)
+		if output != "" {
+			t.Errorf("got output %q, want none", output)
+		}
+		if got := readFile(t, hookOutput); got != "" {
+			t.Errorf("got hook output %q, want none", got)
+		}
+		wantState := "{\n  \"versions\": {\n    \"p1\": \"go1.22.0\",\n    \"p2\": \"3.4.0\"\n  }\n}\n"
+		if got := readFile(t, stateFile); got != wantState {
+			t.Errorf("got state %q, want %q", got, wantState)
+		}
+	})
+
+	t.Run("new releases", func(t *testing.T) {
+		output, _ := run(t, map[string]newreleases.Release{
+			"p1": {Version: "go1.23rc1", IsPrerelease: true, CVE: []string{"CVE-2024-1", "CVE-2024-2"}},
+			"p2": {Version: "3.4.0"},
+			"p3": {Version: "5.0.1"},
+		}, hook)
+		if want := "github golang/go go1.23rc1\n"; output != want {
+			t.Errorf("got output %q, want %q", output, want)
+		}
+		if got, want := readFile(t, hookOutput), "p1 github golang/go go1.23rc1 true CVE-2024-1,CVE-2024-2\n"; got != want {
+			t.Errorf("got hook output %q, want %q", got, want)
+		}
+		wantState := "{\n  \"versions\": {\n    \"p1\": \"go1.23rc1\",\n    \"p2\": \"3.4.0\",\n    \"p3\": \"5.0.1\"\n  }\n}\n"
+		if got := readFile(t, stateFile); got != wantState {
+			t.Errorf("got state %q, want %q", got, wantState)
+		}
+	})
+
+	t.Run("failed command", func(t *testing.T) {
+		_, errorOutput := run(t, map[string]newreleases.Release{
+			"p1": {Version: "go1.23rc1"},
+			"p2": {Version: "3.4.1"},
+			"p3": {Version: "5.0.1"},
+		}, "exit 3")
+		if want := "Error: npm vue 3.4.1: exit status 3\n"; errorOutput != want {
+			t.Errorf("got error output %q, want %q", errorOutput, want)
+		}
+		wantState := "{\n  \"versions\": {\n    \"p1\": \"go1.23rc1\",\n    \"p2\": \"3.4.0\",\n    \"p3\": \"5.0.1\"\n  }\n}\n"
+		if got := readFile(t, stateFile); got != wantState {
+			t.Errorf("got state %q, want %q", got, wantState)
+		}
+	})
+
+	t.Run("provider", func(t *testing.T) {
+		output, _ := run(t, map[string]newreleases.Release{
+			"p1": {Version: "go1.23.0"},
+			"p2": {Version: "3.4.1"},
+		}, hook, "--provider", "npm")
+		if want := "npm vue 3.4.1\n"; output != want {
+			t.Errorf("got output %q, want %q", output, want)
+		}
+		if got := readFile(t, hookOutput); !strings.HasSuffix(got, "p2 npm vue 3.4.1 false \n") {
+			t.Errorf("got hook output %q", got)
+		}
+	})
+}
+
+func TestWatchCmd_listError(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the watcher is stopped by the interrupt signal")
+	}
+
+	stateFile := filepath.Join(t.TempDir(), "state.json")
+	projectsService := &mockFlakyProjectsService{
+		mockProjectsService: newMockProjectsService(1, nil, []newreleases.Project{
+			{ID: "p1", Provider: "github", Name: "golang/go"},
+		}),
+	}
+
+	var outputBuf, errorOutputBuf bytes.Buffer
+	if err := newCommand(t,
+		cmd.WithArgs("watch", "--interval", "10ms", "--state", stateFile, "--exec", "true"),
+		cmd.WithOutput(&outputBuf),
+		cmd.WithErrorOutput(&errorOutputBuf),
+		cmd.WithProjectsService(projectsService),
+		cmd.WithReleasesService(newMockLatestReleasesService(map[string]newreleases.Release{
+			"p1": {Version: "go1.22.0"},
+		})),
+	).Execute(); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "Error: list projects: service unavailable\n"; errorOutputBuf.String() != want {
+		t.Errorf("got error output %q, want %q", errorOutputBuf.String(), want)
+	}
+	data, err := os.ReadFile(stateFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "{\n  \"versions\": {\n    \"p1\": \"go1.22.0\"\n  }\n}\n"; string(data) != want {
+		t.Errorf("got state %q, want %q", data, want)
+	}
+}
+
+// mockFlakyProjectsService fails to list projects on the first call, and
+// interrupts the watcher on the third one.
+type mockFlakyProjectsService struct {
+	mockProjectsService
+	calls int
+}
+
+func (s *mockFlakyProjectsService) List(ctx context.Context, o newreleases.ProjectListOptions) (projects []newreleases.Project, lastPage int, err error) {
+	s.calls++
+	switch s.calls {
+	case 1:
+		return nil, 0, errors.New("service unavailable")
+	case 3:
+		p, err := os.FindProcess(os.Getpid())
+		if err != nil {
+			return nil, 0, err
+		}
+		if err := p.Signal(os.Interrupt); err != nil {
+			return nil, 0, err
+		}
+	}
+	return s.mockProjectsService.List(ctx, o)
+}
+
+// mockLatestReleasesService returns the latest releases by project IDs.
+type mockLatestReleasesService struct {
+	mockReleasesService
+	releases map[string]newreleases.Release
+}
+
+func newMockLatestReleasesService(releases map[string]newreleases.Release) (s mockLatestReleasesService) {
+	return mockLatestReleasesService{releases: releases}
+}
+
+func (s mockLatestReleasesService) GetLatestByProjectID(ctx context.Context, projectID string) (release *newreleases.Release, err error) {
+	r, ok := s.releases[projectID]
+	if !ok {
+		return nil, newreleases.ErrNotFound
+	}
+	return &r, nil
+}
@@ -25,6 +25,10 @@ func (c *command) initWebhookCmd() (err error) {
 				return err
 			}
 
+			if ok, err := c.writeOutput(cmd, webhooks); ok || err != nil {
+				return err
+			}
+
 			if len(webhooks) == 0 {
 				cmd.Println("No Webhooks found.")
 				return nil
@@ -42,6 +46,10 @@ func (c *command) initWebhookCmd() (err error) {
 		},
 	}
 
+	if err := c.initWebhookServeCmd(cmd); err != nil {
+		return err
+	}
+
 	c.root.AddCommand(cmd)
 	return addClientFlags(cmd)
 }
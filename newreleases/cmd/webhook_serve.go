@@ -0,0 +1,166 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"newreleases.io/cmd/webhook"
+)
+
+const optionNameWebhookSecret = "webhook-secret"
+
+func (c *command) initWebhookServeCmd(webhookCmd *cobra.Command) (err error) {
+	var (
+		optionNameAddr      = "addr"
+		optionNamePath      = "path"
+		optionNameSecret    = "secret"
+		optionNameTolerance = "tolerance"
+		optionNamePrint     = "print"
+		optionNameAppend    = "append"
+		optionNameExec      = "exec"
+		optionNameForward   = "forward"
+	)
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Receive Webhook requests and run actions for releases",
+		Long: `Receive Webhook requests and run actions for releases.
+
+An HTTP server accepts POST requests from NewReleases custom Webhooks and
+verifies their signatures and timestamps with the secret that is set with the
+--secret flag, or with the webhook-secret configuration option. For every
+verified request, actions are run in this order:
+
+  --print     print the release as JSON, the default if no other action is set
+  --append    append the release as a line of JSON to a file
+  --exec      run a shell command with the request body on standard input and
+              the release information in NR_PROVIDER, NR_PROJECT, NR_VERSION,
+              NR_DATE, NR_PRERELEASE, NR_UPDATED and NR_CVE environment
+              variables
+  --forward   send the request body to another URL, signed with the same
+              secret
+
+Flags of all actions except --print can be specified multiple times. If any
+action fails, the request is responded with an error status, so that it is
+retried by NewReleases.`,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			flags := cmd.Flags()
+			addr, err := flags.GetString(optionNameAddr)
+			if err != nil {
+				return err
+			}
+			path, err := flags.GetString(optionNamePath)
+			if err != nil {
+				return err
+			}
+			secret, err := flags.GetString(optionNameSecret)
+			if err != nil {
+				return err
+			}
+			if secret == "" {
+				secret = c.config.GetString(optionNameWebhookSecret)
+			}
+			if secret == "" {
+				return errors.New("webhook secret not specified")
+			}
+			tolerance, err := flags.GetDuration(optionNameTolerance)
+			if err != nil {
+				return err
+			}
+			printJSON, err := flags.GetBool(optionNamePrint)
+			if err != nil {
+				return err
+			}
+			appendFiles, err := flags.GetStringArray(optionNameAppend)
+			if err != nil {
+				return err
+			}
+			commands, err := flags.GetStringArray(optionNameExec)
+			if err != nil {
+				return err
+			}
+			urls, err := flags.GetStringArray(optionNameForward)
+			if err != nil {
+				return err
+			}
+			timeout, err := flags.GetDuration(optionNameTimeout)
+			if err != nil {
+				return err
+			}
+
+			var actions []webhook.Action
+			if printJSON || (!flags.Changed(optionNamePrint) && len(appendFiles)+len(commands)+len(urls) == 0) {
+				actions = append(actions, webhook.PrintJSON(cmd.OutOrStdout()))
+			}
+			for _, f := range appendFiles {
+				actions = append(actions, webhook.AppendToFile(f))
+			}
+			for _, command := range commands {
+				actions = append(actions, webhook.RunCommand(command, cmd.ErrOrStderr()))
+			}
+			for _, u := range urls {
+				actions = append(actions, webhook.Forward(u, []byte(secret), &http.Client{Timeout: timeout}))
+			}
+
+			mux := http.NewServeMux()
+			mux.Handle(path, webhook.NewHandler([]byte(secret), actions,
+				webhook.WithTolerance(tolerance),
+				webhook.WithLogger(log.New(cmd.ErrOrStderr(), "", log.LstdFlags)),
+			))
+
+			ln, err := net.Listen("tcp", addr)
+			if err != nil {
+				return err
+			}
+			server := &http.Server{
+				Handler:           mux,
+				ReadHeaderTimeout: 10 * time.Second,
+			}
+
+			ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			errC := make(chan error, 1)
+			go func() {
+				errC <- server.Serve(ln)
+			}()
+			cmd.PrintErrf("Listening on %s.\n", ln.Addr())
+
+			select {
+			case err := <-errC:
+				return err
+			case <-ctx.Done():
+			}
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			return server.Shutdown(shutdownCtx)
+		},
+	}
+
+	cmd.Flags().String(optionNameAddr, ":8080", "address to listen on")
+	cmd.Flags().String(optionNamePath, "/", "URL path of Webhook requests")
+	cmd.Flags().String(optionNameSecret, "", "Webhook secret key")
+	cmd.Flags().Duration(optionNameTolerance, webhook.DefaultTolerance, "maximal age of requests, 0 to disable the check")
+	cmd.Flags().Bool(optionNamePrint, false, "print releases as JSON")
+	cmd.Flags().StringArray(optionNameAppend, nil, "file to append releases to as JSON lines")
+	cmd.Flags().StringArray(optionNameExec, nil, "shell command to run for every release")
+	cmd.Flags().StringArray(optionNameForward, nil, "URL to forward requests to")
+	cmd.Flags().Duration(optionNameTimeout, 30*time.Second, "forward request timeout")
+
+	webhookCmd.AddCommand(cmd)
+	return nil
+}
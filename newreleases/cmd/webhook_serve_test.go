@@ -0,0 +1,142 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cmd_test
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"newreleases.io/cmd/newreleases/cmd"
+	"newreleases.io/cmd/webhook"
+)
+
+func TestWebhookCmd_Serve_noSecret(t *testing.T) {
+	err := newCommand(t,
+		cmd.WithArgs("webhook", "serve", "--addr", "127.0.0.1:0"),
+	).Execute()
+	if want := "webhook secret not specified"; err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+}
+
+func TestWebhookCmd_Serve(t *testing.T) {
+	secret := "webhook secret"
+	body := `{"provider":"github","project":"golang/go","version":"go1.22.0","time":"2024-02-06T18:30:00Z","cve":["CVE-2023-45285"],"is_prerelease":true}`
+
+	var outputBuf, errorOutputBuf lockedBuffer
+	errC := make(chan error, 1)
+	go func() {
+		errC <- newCommand(t,
+			cmd.WithArgs("webhook", "serve", "--addr", "127.0.0.1:0", "--path", "/hook", "--secret", secret),
+			cmd.WithOutput(&outputBuf),
+			cmd.WithErrorOutput(&errorOutputBuf),
+		).Execute()
+	}()
+
+	addr := waitForListenAddr(t, &errorOutputBuf, errC)
+
+	post := func(t *testing.T, secret string) (statusCode int) {
+		t.Helper()
+
+		req, err := http.NewRequest(http.MethodPost, "http://"+addr+"/hook", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(webhook.TimestampHeader, timestamp)
+		req.Header.Set(webhook.SignatureHeader, webhook.Sign([]byte(secret), timestamp, []byte(body)))
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	t.Run("signed request", func(t *testing.T) {
+		if statusCode := post(t, secret); statusCode != http.StatusNoContent {
+			t.Errorf("got status %v, want %v", statusCode, http.StatusNoContent)
+		}
+		if gotOutput, wantOutput := outputBuf.String(), body+"\n"; gotOutput != wantOutput {
+			t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+		}
+	})
+
+	t.Run("badly signed request", func(t *testing.T) {
+		if statusCode := post(t, "invalid secret"); statusCode != http.StatusUnauthorized {
+			t.Errorf("got status %v, want %v", statusCode, http.StatusUnauthorized)
+		}
+		if gotOutput, wantOutput := outputBuf.String(), body+"\n"; gotOutput != wantOutput {
+			t.Errorf("got output %q, want %q", gotOutput, wantOutput)
+		}
+		if gotErrorOutput := errorOutputBuf.String(); !strings.Contains(gotErrorOutput, "rejected request from ") || !strings.Contains(gotErrorOutput, "invalid signature") {
+			t.Errorf("got error output %q, want rejected request with invalid signature", gotErrorOutput)
+		}
+	})
+
+	p, err := os.FindProcess(os.Getpid())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Signal(os.Interrupt); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errC:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timeout waiting for the server to shut down")
+	}
+}
+
+var listenAddrRegexp = regexp.MustCompile(`Listening on (\S+)\.\n`)
+
+// waitForListenAddr returns the address that the webhook serve command
+// reports to listen on.
+func waitForListenAddr(t *testing.T, errorOutput *lockedBuffer, errC <-chan error) (addr string) {
+	t.Helper()
+
+	timeout := time.After(10 * time.Second)
+	for {
+		if m := listenAddrRegexp.FindStringSubmatch(errorOutput.String()); m != nil {
+			return m[1]
+		}
+		select {
+		case err := <-errC:
+			t.Fatalf("command exited with error %v", err)
+		case <-timeout:
+			t.Fatal("timeout waiting for the server to listen")
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// lockedBuffer is a bytes.Buffer that can be written and read concurrently.
+type lockedBuffer struct {
+	buf bytes.Buffer
+	mu  sync.Mutex
+}
+
+func (b *lockedBuffer) Write(p []byte) (n int, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *lockedBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
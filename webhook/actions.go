@@ -0,0 +1,120 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PrintJSON returns an action that writes the release as a line of JSON.
+func PrintJSON(w io.Writer) Action {
+	var mu sync.Mutex
+	return func(ctx context.Context, r Release, body []byte) error {
+		data, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		_, err = w.Write(append(data, '\n'))
+		return err
+	}
+}
+
+// AppendToFile returns an action that appends the release as a line of JSON
+// to the file, creating it if it does not exist.
+func AppendToFile(filename string) Action {
+	var mu sync.Mutex
+	return func(ctx context.Context, r Release, body []byte) (err error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+		}()
+		return PrintJSON(f)(ctx, r, body)
+	}
+}
+
+// RunCommand returns an action that runs the command by the shell with the
+// request body on standard input and the release information in environment
+// variables NR_PROVIDER, NR_PROJECT, NR_VERSION, NR_DATE, NR_PRERELEASE,
+// NR_UPDATED and NR_CVE. The output of the command is written to w.
+func RunCommand(command string, w io.Writer) Action {
+	return func(ctx context.Context, r Release, body []byte) error {
+		var c *exec.Cmd
+		if runtime.GOOS == "windows" {
+			c = exec.CommandContext(ctx, "cmd", "/C", command)
+		} else {
+			c = exec.CommandContext(ctx, "sh", "-c", command)
+		}
+		c.Env = append(os.Environ(),
+			"NR_PROVIDER="+r.Provider,
+			"NR_PROJECT="+r.Project,
+			"NR_VERSION="+r.Version,
+			"NR_DATE="+r.Time.Format(time.RFC3339),
+			"NR_PRERELEASE="+strconv.FormatBool(r.IsPrerelease),
+			"NR_UPDATED="+strconv.FormatBool(r.IsUpdated),
+			"NR_CVE="+strings.Join(r.CVE, ","),
+		)
+		c.Stdin = bytes.NewReader(body)
+		c.Stdout = w
+		c.Stderr = w
+		return c.Run()
+	}
+}
+
+// Forward returns an action that sends the request body to the URL with a
+// POST request. If secret is not empty, the request is signed with it in the
+// same way as webhook requests are signed, otherwise it is not signed. If
+// client is nil, http.DefaultClient is used.
+func Forward(url string, secret []byte, client *http.Client) Action {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return func(ctx context.Context, r Release, body []byte) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if len(secret) > 0 {
+			timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+			req.Header.Set(TimestampHeader, timestamp)
+			req.Header.Set(SignatureHeader, Sign(secret, timestamp, body))
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		_, _ = io.Copy(io.Discard, resp.Body)
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("forward to %s: %s", url, resp.Status)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"time"
+)
+
+// maxBodySize is the maximal size of the request body that is accepted.
+const maxBodySize = 1 << 20
+
+// Action is called for every verified webhook request with the decoded
+// release and the request body.
+type Action func(ctx context.Context, r Release, body []byte) error
+
+// Handler is an HTTP handler that verifies webhook requests and calls actions
+// in the order in which they are set. A request is responded with an error
+// status if any of the actions fails.
+type Handler struct {
+	secret    []byte
+	actions   []Action
+	tolerance time.Duration
+	now       func() time.Time
+	logger    *log.Logger
+}
+
+// HandlerOption sets optional parameters of the Handler.
+type HandlerOption func(h *Handler)
+
+// WithTolerance sets the maximal difference between the request timestamp and
+// the current time. The default is DefaultTolerance, and if it is not greater
+// than zero, timestamps are not checked.
+func WithTolerance(d time.Duration) HandlerOption {
+	return func(h *Handler) {
+		h.tolerance = d
+	}
+}
+
+// WithNow sets the function that returns the current time.
+func WithNow(now func() time.Time) HandlerOption {
+	return func(h *Handler) {
+		h.now = now
+	}
+}
+
+// WithLogger sets the logger for rejected requests and failed actions.
+func WithLogger(l *log.Logger) HandlerOption {
+	return func(h *Handler) {
+		h.logger = l
+	}
+}
+
+// NewHandler returns a Handler that verifies requests with the secret and
+// calls actions for every release.
+func NewHandler(secret []byte, actions []Action, opts ...HandlerOption) *Handler {
+	h := &Handler{
+		secret:    secret,
+		actions:   actions,
+		tolerance: DefaultTolerance,
+		now:       time.Now,
+		logger:    log.New(io.Discard, "", 0),
+	}
+	for _, o := range opts {
+		o(h)
+	}
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodySize))
+	if err != nil {
+		var e *http.MaxBytesError
+		if errors.As(err, &e) {
+			http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if err := Verify(h.secret, r.Header.Get(TimestampHeader), r.Header.Get(SignatureHeader), body, h.now(), h.tolerance); err != nil {
+		h.logger.Printf("rejected request from %s: %v", r.RemoteAddr, err)
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+
+	var release Release
+	if err := json.Unmarshal(body, &release); err != nil {
+		h.logger.Printf("invalid payload from %s: %v", r.RemoteAddr, err)
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	for _, a := range h.actions {
+		if err := a(r.Context(), release, body); err != nil {
+			h.logger.Printf("%s: %v", release, err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"newreleases.io/cmd/webhook"
+)
+
+var (
+	testSecret = []byte("secret")
+	testNow    = time.Unix(1700000000, 0)
+	testBody   = `{"provider":"github","project":"golang/go","version":"go1.22.0","time":"2024-02-06T18:30:00Z","cve":["CVE-2023-45285"],"is_prerelease":true}`
+)
+
+func newSignedRequest(t *testing.T, body string) *http.Request {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	timestamp := strconv.FormatInt(testNow.Unix(), 10)
+	r.Header.Set(webhook.TimestampHeader, timestamp)
+	r.Header.Set(webhook.SignatureHeader, webhook.Sign(testSecret, timestamp, []byte(body)))
+	return r
+}
+
+func serve(h http.Handler, r *http.Request) *http.Response {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w.Result()
+}
+
+func TestHandler(t *testing.T) {
+	var got []webhook.Release
+	var gotBody string
+	record := func(ctx context.Context, r webhook.Release, body []byte) error {
+		got = append(got, r)
+		gotBody = string(body)
+		return nil
+	}
+	h := webhook.NewHandler(testSecret, []webhook.Action{record}, webhook.WithNow(func() time.Time { return testNow }))
+
+	t.Run("release", func(t *testing.T) {
+		got = nil
+		resp := serve(h, newSignedRequest(t, testBody))
+		if resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("got status %v, want %v", resp.StatusCode, http.StatusNoContent)
+		}
+		want := webhook.Release{
+			Provider:     "github",
+			Project:      "golang/go",
+			Version:      "go1.22.0",
+			Time:         time.Date(2024, 2, 6, 18, 30, 0, 0, time.UTC),
+			CVE:          []string{"CVE-2023-45285"},
+			IsPrerelease: true,
+		}
+		if len(got) != 1 || got[0].String() != want.String() || !got[0].Time.Equal(want.Time) || !got[0].IsPrerelease || len(got[0].CVE) != 1 {
+			t.Errorf("got releases %+v, want %+v", got, want)
+		}
+		if gotBody != testBody {
+			t.Errorf("got body %q, want %q", gotBody, testBody)
+		}
+	})
+
+	for _, tc := range []struct {
+		name       string
+		request    func(t *testing.T) *http.Request
+		wantStatus int
+	}{
+		{
+			name: "method not allowed",
+			request: func(t *testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodGet, "/", nil)
+			},
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name: "not signed",
+			request: func(t *testing.T) *http.Request {
+				return httptest.NewRequest(http.MethodPost, "/", strings.NewReader(testBody))
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "invalid signature",
+			request: func(t *testing.T) *http.Request {
+				r := newSignedRequest(t, testBody)
+				r.Header.Set(webhook.SignatureHeader, webhook.Sign([]byte("other"), r.Header.Get(webhook.TimestampHeader), []byte(testBody)))
+				return r
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "invalid payload",
+			request: func(t *testing.T) *http.Request {
+				return newSignedRequest(t, `not json`)
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "too large",
+			request: func(t *testing.T) *http.Request {
+				return newSignedRequest(t, strings.Repeat(" ", 2<<20))
+			},
+			wantStatus: http.StatusRequestEntityTooLarge,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got = nil
+			resp := serve(h, tc.request(t))
+			if resp.StatusCode != tc.wantStatus {
+				t.Errorf("got status %v, want %v", resp.StatusCode, tc.wantStatus)
+			}
+			if len(got) != 0 {
+				t.Errorf("got releases %+v, want none", got)
+			}
+		})
+	}
+
+	t.Run("failed action", func(t *testing.T) {
+		var called bool
+		var logBuf bytes.Buffer
+		h := webhook.NewHandler(testSecret, []webhook.Action{
+			func(ctx context.Context, r webhook.Release, body []byte) error {
+				return errors.New("test error")
+			},
+			func(ctx context.Context, r webhook.Release, body []byte) error {
+				called = true
+				return nil
+			},
+		}, webhook.WithTolerance(0), webhook.WithLogger(log.New(&logBuf, "", 0)))
+
+		resp := serve(h, newSignedRequest(t, testBody))
+		if resp.StatusCode != http.StatusInternalServerError {
+			t.Errorf("got status %v, want %v", resp.StatusCode, http.StatusInternalServerError)
+		}
+		if called {
+			t.Error("action after the failed one called")
+		}
+		if want := "github golang/go go1.22.0: test error\n"; logBuf.String() != want {
+			t.Errorf("got log %q, want %q", logBuf.String(), want)
+		}
+	})
+}
+
+func TestActions(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "releases.jsonl")
+	wantJSON := `{"provider":"github","project":"golang/go","version":"go1.22.0","time":"2024-02-06T18:30:00Z","cve":["CVE-2023-45285"],"is_prerelease":true}` + "\n"
+
+	var forwarded []*http.Request
+	var forwardedBodies []string
+	forwardServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		forwarded = append(forwarded, r)
+		forwardedBodies = append(forwardedBodies, string(body))
+	}))
+	defer forwardServer.Close()
+
+	var printBuf, commandBuf bytes.Buffer
+	actions := []webhook.Action{
+		webhook.PrintJSON(&printBuf),
+		webhook.AppendToFile(file),
+		webhook.Forward(forwardServer.URL, testSecret, nil),
+	}
+	if runtime.GOOS != "windows" {
+		actions = append(actions, webhook.RunCommand(`echo "$NR_PROVIDER $NR_PROJECT $NR_VERSION $NR_PRERELEASE $NR_UPDATED $NR_CVE $NR_DATE"; wc -c`, &commandBuf))
+	}
+	h := webhook.NewHandler(testSecret, actions, webhook.WithTolerance(0))
+
+	for i := 0; i < 2; i++ {
+		if resp := serve(h, newSignedRequest(t, testBody)); resp.StatusCode != http.StatusNoContent {
+			t.Fatalf("got status %v, want %v", resp.StatusCode, http.StatusNoContent)
+		}
+	}
+
+	if got, want := printBuf.String(), strings.Repeat(wantJSON, 2); got != want {
+		t.Errorf("got printed %q, want %q", got, want)
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(data), strings.Repeat(wantJSON, 2); got != want {
+		t.Errorf("got file %q, want %q", got, want)
+	}
+
+	if len(forwarded) != 2 {
+		t.Fatalf("got %v forwarded requests, want 2", len(forwarded))
+	}
+	for i, r := range forwarded {
+		if forwardedBodies[i] != testBody {
+			t.Errorf("got forwarded body %q, want %q", forwardedBodies[i], testBody)
+		}
+		if err := webhook.Verify(testSecret, r.Header.Get(webhook.TimestampHeader), r.Header.Get(webhook.SignatureHeader), []byte(forwardedBodies[i]), time.Now(), webhook.DefaultTolerance); err != nil {
+			t.Errorf("forwarded request: %v", err)
+		}
+	}
+
+	if runtime.GOOS != "windows" {
+		line := "github golang/go go1.22.0 true false CVE-2023-45285 2024-02-06T18:30:00Z\n"
+		if got, want := commandBuf.String(), strings.Repeat(line+strconv.Itoa(len(testBody))+"\n", 2); strings.ReplaceAll(got, " ", "") != strings.ReplaceAll(want, " ", "") {
+			t.Errorf("got command output %q, want %q", got, want)
+		}
+	}
+}
+
+func TestForward_errorStatus(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer s.Close()
+
+	err := webhook.Forward(s.URL, nil, nil)(context.Background(), webhook.Release{}, []byte(testBody))
+	if want := "forward to " + s.URL + ": 502 Bad Gateway"; err == nil || err.Error() != want {
+		t.Errorf("got error %v, want %v", err, want)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package webhook provides an HTTP handler that receives NewReleases webhook
+// requests, verifies their signatures and dispatches releases to actions.
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// Headers of webhook requests with the signature of the request body and the
+// Unix time when the request was signed.
+const (
+	SignatureHeader = "X-Newreleases-Signature"
+	TimestampHeader = "X-Newreleases-Timestamp"
+)
+
+// DefaultTolerance is the maximal difference between the request timestamp
+// and the current time for the request to be accepted.
+const DefaultTolerance = 5 * time.Minute
+
+var (
+	ErrMissingSignature = errors.New("missing signature")
+	ErrInvalidSignature = errors.New("invalid signature")
+	ErrInvalidTimestamp = errors.New("invalid timestamp")
+	ErrExpiredTimestamp = errors.New("expired timestamp")
+)
+
+// Release is the payload of a webhook request about a new release.
+type Release struct {
+	Provider     string    `json:"provider"`
+	Project      string    `json:"project"`
+	Version      string    `json:"version"`
+	Time         time.Time `json:"time"`
+	CVE          []string  `json:"cve,omitempty"`
+	IsPrerelease bool      `json:"is_prerelease,omitempty"`
+	IsUpdated    bool      `json:"is_updated,omitempty"`
+}
+
+// String returns the provider, project and version of the release.
+func (r Release) String() string {
+	return r.Provider + " " + r.Project + " " + r.Version
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the timestamp and the
+// body, joined with a dot, as it is sent in the SignatureHeader.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks that the signature is valid for the timestamp and the body,
+// and that the timestamp is not further than tolerance from now. If tolerance
+// is not greater than zero, the timestamp is not checked.
+func Verify(secret []byte, timestamp, signature string, body []byte, now time.Time, tolerance time.Duration) error {
+	if signature == "" || timestamp == "" {
+		return ErrMissingSignature
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+	want, _ := hex.DecodeString(Sign(secret, timestamp, body))
+	if !hmac.Equal(got, want) {
+		return ErrInvalidSignature
+	}
+
+	if tolerance <= 0 {
+		return nil
+	}
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	if d := now.Sub(time.Unix(sec, 0)); d > tolerance || d < -tolerance {
+		return ErrExpiredTimestamp
+	}
+	return nil
+}
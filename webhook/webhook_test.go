@@ -0,0 +1,124 @@
+// Copyright (c) 2026, NewReleases CLI AUTHORS.
+// All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package webhook_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"newreleases.io/cmd/webhook"
+)
+
+func TestVerify(t *testing.T) {
+	secret := []byte("secret")
+	body := []byte(`{"provider":"github","project":"golang/go","version":"go1.22.0"}`)
+	now := time.Unix(1700000000, 0)
+	timestamp := "1700000000"
+	signature := webhook.Sign(secret, timestamp, body)
+
+	if want := "483ab9a2cc258d402146ab88eddae9dfcd91709ad2f702ddcbb3dd0541a0308d"; signature != want {
+		t.Fatalf("got signature %q, want %q", signature, want)
+	}
+
+	for _, tc := range []struct {
+		name      string
+		secret    []byte
+		timestamp string
+		signature string
+		body      []byte
+		now       time.Time
+		tolerance time.Duration
+		wantError error
+	}{
+		{
+			name:      "valid",
+			timestamp: timestamp,
+			signature: signature,
+			now:       now.Add(time.Minute),
+			tolerance: webhook.DefaultTolerance,
+		},
+		{
+			name:      "missing signature",
+			timestamp: timestamp,
+			now:       now,
+			wantError: webhook.ErrMissingSignature,
+		},
+		{
+			name:      "missing timestamp",
+			signature: signature,
+			now:       now,
+			wantError: webhook.ErrMissingSignature,
+		},
+		{
+			name:      "invalid secret",
+			secret:    []byte("other"),
+			timestamp: timestamp,
+			signature: signature,
+			now:       now,
+			wantError: webhook.ErrInvalidSignature,
+		},
+		{
+			name:      "modified body",
+			timestamp: timestamp,
+			signature: signature,
+			body:      []byte(`{"provider":"github","project":"golang/go","version":"go1.23.0"}`),
+			now:       now,
+			wantError: webhook.ErrInvalidSignature,
+		},
+		{
+			name:      "modified timestamp",
+			timestamp: "1700000001",
+			signature: signature,
+			now:       now,
+			wantError: webhook.ErrInvalidSignature,
+		},
+		{
+			name:      "not hex signature",
+			timestamp: timestamp,
+			signature: "signature",
+			now:       now,
+			wantError: webhook.ErrInvalidSignature,
+		},
+		{
+			name:      "expired",
+			timestamp: timestamp,
+			signature: signature,
+			now:       now.Add(10 * time.Minute),
+			tolerance: webhook.DefaultTolerance,
+			wantError: webhook.ErrExpiredTimestamp,
+		},
+		{
+			name:      "from future",
+			timestamp: timestamp,
+			signature: signature,
+			now:       now.Add(-10 * time.Minute),
+			tolerance: webhook.DefaultTolerance,
+			wantError: webhook.ErrExpiredTimestamp,
+		},
+		{
+			name:      "expired without tolerance",
+			timestamp: timestamp,
+			signature: signature,
+			now:       now.Add(24 * time.Hour),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := secret
+			if tc.secret != nil {
+				s = tc.secret
+			}
+			b := body
+			if tc.body != nil {
+				b = tc.body
+			}
+			err := webhook.Verify(s, tc.timestamp, tc.signature, b, tc.now, tc.tolerance)
+			if !errors.Is(err, tc.wantError) {
+				t.Errorf("got error %v, want %v", err, tc.wantError)
+			}
+		})
+	}
+}